@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
+	"github.com/thewizardplusplus/go-atari-cli/encoding/coordinates"
+	"github.com/thewizardplusplus/go-atari-cli/engine"
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+// it analyzes a single position and writes the best move
+// and the ranked candidates
+func runAnalyze(arguments []string) error {
+	flags := flag.NewFlagSet(analyzeCommand, flag.ExitOnError)
+	storageInSGF := flags.String(
+		"sgf",
+		"",
+		"board in Smart Game Format (default: empty board 5x5)",
+	)
+	color := flags.String(
+		"color",
+		"black",
+		"color to move (allowed: black, white)",
+	)
+	coordinateSystem := flags.String(
+		"coordinates",
+		"sgf",
+		"coordinate system for moves (allowed: sgf, gtp, numeric)",
+	)
+	searchFlags := newSearchFlags(flags)
+	flags.Parse(arguments) // nolint: errcheck
+
+	storage, err := sgf.DecodeStoneStorage(*storageInSGF, models.NewBoard)
+	if err != nil {
+		return fmt.Errorf("unable to decode the board: %s", err)
+	}
+
+	parsedColor, err := ascii.DecodeColor(*color)
+	if err != nil {
+		return fmt.Errorf("unable to decode the color: %s", err)
+	}
+
+	system, err := coordinates.DecodeSystem(*coordinateSystem)
+	if err != nil {
+		return fmt.Errorf("unable to decode the coordinate system: %s", err)
+	}
+
+	settings, err := searchFlags.settings()
+	if err != nil {
+		return fmt.Errorf("unable to decode the search settings: %s", err)
+	}
+	runtime.GOMAXPROCS(settings.concurrency.Threads)
+
+	result, err := search(storage, parsedColor, settings)
+	if err != nil {
+		return fmt.Errorf("unable to search the move: %s", err)
+	}
+
+	node := result.node
+	best := engine.Candidate{Move: node.Move, State: node.State}
+	fmt.Printf("best move: %s\n", system.EncodePoint(best.Move.Point))
+	if result.fromBook {
+		fmt.Println("book replies:")
+		entries := settings.book.Entries(storage, parsedColor)
+		for index, entry := range entries {
+			fmt.Printf(
+				"%d. %s (weight %d)\n",
+				index+1,
+				system.EncodePoint(entry.Move.Point),
+				entry.Weight,
+			)
+		}
+
+		return nil
+	}
+
+	if result.proof == engine.ProvenWin {
+		// a proven win is chosen without games, so there are no statistics
+		fmt.Printf("proof: %s\n", result.proof)
+		return nil
+	}
+
+	fmt.Printf("win rate: %.1f%%\n", 100*best.WinRate())
+	if result.proof != engine.Unproven {
+		fmt.Printf("proof: %s\n", result.proof)
+	}
+
+	if node.Parent != nil {
+		fmt.Println("candidates:")
+		for index, candidate := range engine.RankCandidates(node.Parent) {
+			fmt.Printf(
+				"%d. %s %.1f%% (%d games)\n",
+				index+1,
+				system.EncodePoint(candidate.Move.Point),
+				100*candidate.WinRate(),
+				candidate.State.GameCount,
+			)
+		}
+	}
+
+	return nil
+}
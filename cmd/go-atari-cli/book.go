@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
+	"github.com/thewizardplusplus/go-atari-cli/engine"
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+// it dispatches commands of the opening book
+func runBook(arguments []string) error {
+	if len(arguments) == 0 {
+		return errors.New("missed book command")
+	}
+
+	switch arguments[0] {
+	case bookBuildCommand:
+		return runBookBuild(arguments[1:]) // don't wrap
+	default:
+		return fmt.Errorf("unknown book command %q", arguments[0])
+	}
+}
+
+// it builds the opening book by searches from the initial position;
+// entries of the book specified by the -book flag are kept, and positions
+// already present in it aren't searched again
+func runBookBuild(arguments []string) error {
+	flags := flag.NewFlagSet(bookCommand+" "+bookBuildCommand, flag.ExitOnError)
+	storageInSGF := flags.String(
+		"sgf",
+		"",
+		"initial board in Smart Game Format (default: empty board 5x5)",
+	)
+	color := flags.String(
+		"color",
+		"black",
+		"color to move in the initial position (allowed: black, white)",
+	)
+	depth := flags.Int("depth", 4, "count of plies covered by the book")
+	width := flags.Int(
+		"width",
+		2,
+		"count of the best candidates added to the book in each position",
+	)
+	output := flags.String(
+		"output",
+		"",
+		"path to the built book (default: the -book flag value)",
+	)
+	searchFlags := newSearchFlags(flags)
+	flags.Parse(arguments) // nolint: errcheck
+
+	storage, err := sgf.DecodeStoneStorage(*storageInSGF, models.NewBoard)
+	if err != nil {
+		return fmt.Errorf("unable to decode the board: %s", err)
+	}
+
+	parsedColor, err := ascii.DecodeColor(*color)
+	if err != nil {
+		return fmt.Errorf("unable to decode the color: %s", err)
+	}
+
+	if *depth <= 0 || *width <= 0 {
+		return fmt.Errorf("incorrect depth %d or width %d", *depth, *width)
+	}
+
+	outputPath := *output
+	if outputPath == "" {
+		outputPath = *searchFlags.book
+	}
+	if outputPath == "" {
+		return errors.New("missed output path")
+	}
+
+	settings, err := searchFlags.settings()
+	if err != nil {
+		return fmt.Errorf("unable to decode the search settings: %s", err)
+	}
+	runtime.GOMAXPROCS(settings.concurrency.Threads)
+
+	book := engine.NewBook(storage.Size())
+	if settings.book != nil {
+		if settings.book.Size() != storage.Size() {
+			return errors.New("the book is for another board size")
+		}
+
+		book = *settings.book
+	}
+	// the book is being built, so it isn't consulted by searches
+	settings.book = nil
+
+	err = buildBook(book, storage, parsedColor, *depth, *width, settings)
+	if err != nil {
+		return err // don't wrap
+	}
+
+	err = ioutil.WriteFile(outputPath, []byte(book.Encode()), 0644)
+	if err != nil {
+		return fmt.Errorf("unable to write the book: %s", err)
+	}
+
+	return nil
+}
+
+// it adds the best candidates of the position to the book and recurses
+// into them until the depth is exhausted
+func buildBook(
+	book engine.Book,
+	storage models.StoneStorage,
+	color models.Color,
+	depth int,
+	width int,
+	settings searchSettings,
+) error {
+	if depth == 0 || check(storage, color) != nil {
+		return nil
+	}
+
+	entries := book.Entries(storage, color)
+	if len(entries) == 0 {
+		result, err := search(storage, color, settings)
+		if err != nil {
+			return fmt.Errorf("unable to search the move: %s", err)
+		}
+
+		candidates := []engine.Candidate{
+			{Move: result.node.Move, State: result.node.State},
+		}
+		if result.node.Parent != nil {
+			candidates = engine.RankCandidates(result.node.Parent)
+		}
+		for _, candidate := range candidates {
+			if len(entries) == width {
+				break
+			}
+
+			// a move chosen without a tree search has no games
+			weight := max(candidate.State.GameCount, 1)
+			book.Add(storage, candidate.Move, weight)
+
+			entries = append(entries, engine.BookEntry{
+				Move:   candidate.Move,
+				Weight: weight,
+			})
+		}
+	}
+
+	for _, entry := range entries {
+		nextStorage := storage.ApplyMove(entry.Move)
+		err := buildBook(
+			book,
+			nextStorage,
+			color.Negative(),
+			depth-1,
+			width,
+			settings,
+		)
+		if err != nil {
+			return err // don't wrap
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"math"
 	"math/rand"
@@ -13,9 +15,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ansi"
 	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
+	"github.com/thewizardplusplus/go-atari-cli/encoding/coordinates"
+	"github.com/thewizardplusplus/go-atari-cli/encoding/report"
 	"github.com/thewizardplusplus/go-atari-cli/encoding/unicode"
+	"github.com/thewizardplusplus/go-atari-cli/engine"
 	climodels "github.com/thewizardplusplus/go-atari-cli/models"
+	"github.com/thewizardplusplus/go-atari-cli/terminal"
 	models "github.com/thewizardplusplus/go-atari-models"
 	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
 	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
@@ -29,7 +36,32 @@ import (
 )
 
 const (
-	ucbFactor = math.Sqrt2
+	defaultUCBFactor = math.Sqrt2
+	raveEquivalence  = 1000
+	solverNodeCount  = 200000
+	ucbScorer        = "ucb"
+	ucb1TunedScorer  = "ucb1-tuned"
+	winRateScorer    = "win-rate"
+	raveScorer       = "rave"
+	randomRollout    = "random"
+	heuristicRollout = "heuristic"
+	movesCommand     = "moves"
+	textFormat       = "text"
+	jsonFormat       = "json"
+	// subcommands
+	analyzeCommand   = "analyze"
+	bookCommand      = "book"
+	bookBuildCommand = "build"
+
+	// it's a lower bound of moves expected until the game end
+	// for allocating thinking time from a clock
+	minimalExpectedMoves = 10
+	// the two best root moves are close, if the game count of the second one
+	// is at least this share of the game count of the first one
+	closeCandidatesRatio = 0.9
+	// it's a share of the building duration that the exact solver may take;
+	// its actual time is subtracted from the building duration
+	solverDurationShare = 0.5
 )
 
 // nolint: gochecknoglobals
@@ -38,11 +70,33 @@ var (
 		HorizontalLine: "-",
 		VerticalLine:   "|",
 		Crosshairs:     "+",
+		StarPoint:      "*",
 	}
 	unicodePlaceholders = ascii.Placeholders{
 		HorizontalLine: "\u2500",
 		VerticalLine:   "\u2502",
 		Crosshairs:     "\u253c",
+		StarPoint:      "\u254b",
+	}
+	asciiMoveMarks = moveMarks{
+		legal:   ".",
+		illegal: "x",
+	}
+	unicodeMoveMarks = moveMarks{
+		legal:   "\u00b7",
+		illegal: "\u00d7",
+	}
+	asciiRemovedStoneMark   = "o"
+	unicodeRemovedStoneMark = "\u25cc"
+	// they're used instead of underlining without colors;
+	// they differ from marks of stones in atari, removed stones and moves
+	asciiAddedStoneMarks = stoneMarks{
+		black: "#",
+		white: "@",
+	}
+	unicodeAddedStoneMarks = stoneMarks{
+		black: "\u25b2",
+		white: "\u25b3",
 	}
 
 	baseWideMargins = ascii.Margins{
@@ -79,100 +133,588 @@ var (
 	}
 )
 
-type colorCodeGroup map[models.Color]int
+type moveMarks struct {
+	legal   string
+	illegal string
+}
 
-func colorize(
-	text string,
-	color models.Color,
-	colorsCodes colorCodeGroup,
-) string {
-	return setTTYMode(colorsCodes[color]) + text + setTTYMode(0)
+type stoneMarks struct {
+	black string
+	white string
 }
 
-func setTTYMode(mode int) string {
-	return fmt.Sprintf("\x1b[%dm", mode)
+func (marks stoneMarks) encode(color models.Color) string {
+	if color == models.Black {
+		return marks.black
+	}
+
+	return marks.white
 }
 
 type searchSettings struct {
+	ucbFactor              float64
+	scorer                 string
+	rollout                string
+	transpositions         bool
+	solverThreshold        int
+	symmetry               bool
 	maximalPass            int
 	maximalDuration        time.Duration
+	adaptiveTime           bool
+	timeExtension          float64
 	parallelSimulator      bool
 	parallelBulkySimulator bool
 	parallelBuilder        bool
+	concurrency            engine.Concurrency
+	// nil value disables the opening book
+	book *engine.Book
+}
+
+// it's a result of search()
+type searchResult struct {
+	node *tree.Node
+	// it's engine.Unproven, if the exact solver wasn't used or has failed
+	proof engine.Proof
+	// the move is taken from the opening book without a tree search
+	fromBook bool
+}
+
+// nil value disables time controls
+type gameClocks map[models.Color]climodels.Clock
+
+type searchFlags struct {
+	ucbFactor              *float64
+	scorer                 *string
+	rollout                *string
+	transpositions         *bool
+	solverThreshold        *int
+	symmetry               *bool
+	passes                 *int
+	duration               *time.Duration
+	adaptiveTime           *bool
+	timeExtension          *float64
+	parallelSimulator      *bool
+	parallelBulkySimulator *bool
+	parallelBuilder        *bool
+	simulatorConcurrency   *int
+	builderConcurrency     *int
+	threads                *int
+	book                   *string
 }
 
+// it registers flags of search settings shared by the game and subcommands
+func newSearchFlags(flags *flag.FlagSet) searchFlags {
+	return searchFlags{
+		ucbFactor: flags.Float64(
+			"ucbFactor",
+			defaultUCBFactor,
+			"exploration factor of the node scorer",
+		),
+		scorer: flags.String(
+			"scorer",
+			ucbScorer,
+			"node scorer (allowed: ucb, ucb1-tuned, win-rate, rave; "+
+				"win-rate means UCB for tree building and win rate for move selection)",
+		),
+		rollout: flags.String(
+			"rollout",
+			randomRollout,
+			"rollout policy (allowed: random, heuristic; heuristic prefers "+
+				"captures and escapes from atari and avoids self-atari)",
+		),
+		transpositions: flags.Bool(
+			"transpositions",
+			false,
+			"share statistics between transpositions (i.e. the same positions "+
+				"reached by different move orders)",
+		),
+		solverThreshold: flags.Int(
+			"solverThreshold",
+			16,
+			"maximal count of empty points for using the exact solver "+
+				"(0 disables it)",
+		),
+		symmetry: flags.Bool(
+			"symmetry",
+			true,
+			"prune root moves equivalent under rotations and reflections "+
+				"of the board",
+		),
+		passes: flags.Int("passes", 1000, "building passes"),
+		duration: flags.Duration(
+			"duration",
+			10*time.Second,
+			"building duration (e.g. 72h3m0.5s)",
+		),
+		adaptiveTime: flags.Bool(
+			"adaptiveTime",
+			true,
+			"stop building early, when the best move can't be overtaken, "+
+				"and extend it, when the two best moves are close",
+		),
+		timeExtension: flags.Float64(
+			"timeExtension",
+			0.5,
+			"maximal extension of the building duration as its share "+
+				"(it requires -adaptiveTime)",
+		),
+		parallelSimulator: flags.Bool(
+			"parallelSimulator",
+			false,
+			"use parallel game simulating of a single node child",
+		),
+		parallelBulkySimulator: flags.Bool(
+			"parallelBulkySimulator",
+			false,
+			"use parallel game simulating of all node children",
+		),
+		parallelBuilder: flags.Bool(
+			"parallelBuilder",
+			true,
+			"use parallel tree building",
+		),
+		simulatorConcurrency: flags.Int(
+			"simulatorConcurrency",
+			0,
+			"threads of the parallel simulator (default: automatic)",
+		),
+		builderConcurrency: flags.Int(
+			"builderConcurrency",
+			0,
+			"threads of the parallel builder (default: automatic)",
+		),
+		threads: flags.Int(
+			"threads",
+			0,
+			"cap for the total count of search threads (default: the CPU count)",
+		),
+		book: flags.String(
+			"book",
+			"",
+			"path to the opening book (default: no book)",
+		),
+	}
+}
+
+func (flags searchFlags) settings() (searchSettings, error) {
+	switch *flags.scorer {
+	case ucbScorer, ucb1TunedScorer, winRateScorer, raveScorer:
+	default:
+		return searchSettings{}, fmt.Errorf("unknown scorer %q", *flags.scorer)
+	}
+	if *flags.rollout != randomRollout && *flags.rollout != heuristicRollout {
+		return searchSettings{}, fmt.Errorf(
+			"unknown rollout policy %q",
+			*flags.rollout,
+		)
+	}
+	if *flags.solverThreshold < 0 {
+		return searchSettings{}, fmt.Errorf(
+			"negative solver threshold %d",
+			*flags.solverThreshold,
+		)
+	}
+	if *flags.timeExtension < 0 {
+		return searchSettings{}, fmt.Errorf(
+			"negative time extension %g",
+			*flags.timeExtension,
+		)
+	}
+	if *flags.ucbFactor < 0 {
+		return searchSettings{}, fmt.Errorf(
+			"negative exploration factor %g",
+			*flags.ucbFactor,
+		)
+	}
+
+	concurrency, err := engine.ResolveConcurrency(
+		engine.Concurrency{
+			Threads:   *flags.threads,
+			Simulator: *flags.simulatorConcurrency,
+			Builder:   *flags.builderConcurrency,
+		},
+		*flags.parallelSimulator,
+		*flags.parallelBuilder,
+		runtime.NumCPU(),
+	)
+	if err != nil {
+		return searchSettings{}, fmt.Errorf(
+			"unable to resolve the concurrency: %s",
+			err,
+		)
+	}
+
+	settings := searchSettings{
+		ucbFactor:              *flags.ucbFactor,
+		scorer:                 *flags.scorer,
+		rollout:                *flags.rollout,
+		transpositions:         *flags.transpositions,
+		solverThreshold:        *flags.solverThreshold,
+		symmetry:               *flags.symmetry,
+		maximalPass:            *flags.passes,
+		maximalDuration:        *flags.duration,
+		adaptiveTime:           *flags.adaptiveTime,
+		timeExtension:          *flags.timeExtension,
+		parallelSimulator:      *flags.parallelSimulator,
+		parallelBulkySimulator: *flags.parallelBulkySimulator,
+		parallelBuilder:        *flags.parallelBuilder,
+		concurrency:            concurrency,
+	}
+	if *flags.book != "" {
+		book, err := loadBook(*flags.book)
+		if err != nil {
+			return searchSettings{}, err // don't wrap
+		}
+
+		settings.book = &book
+	}
+	if settings.usesOwnBuilder() &&
+		(settings.parallelSimulator || settings.parallelBulkySimulator) {
+		return searchSettings{}, errors.New(
+			"the RAVE scorer and transpositions are incompatible " +
+				"with parallel simulators",
+		)
+	}
+	// node states are replaced by shared ones, so merging of tree copies
+	// built in parallel would count the same games several times
+	if settings.transpositions && settings.parallelBuilder {
+		return searchSettings{}, errors.New(
+			"transpositions are incompatible with the parallel builder",
+		)
+	}
+
+	return settings, nil
+}
+
+// the own builder simulates nodes by itself, so simulators aren't used
+func (settings searchSettings) usesOwnBuilder() bool {
+	return settings.scorer == raveScorer || settings.transpositions
+}
+
+// it limits the search by thinking time allocated from the clock
+// instead of the building duration
+func (settings searchSettings) withClock(
+	clock climodels.Clock,
+	storage models.StoneStorage,
+) searchSettings {
+	// each player makes a move on a half of empty points at most
+	expectedMoves :=
+		max(engine.EmptyPointCount(storage)/2, minimalExpectedMoves)
+	allocation := clock.Allocate(expectedMoves)
+	if settings.adaptiveTime {
+		// the extended duration should fit the allocation too
+		extendedShare := 1 + settings.timeExtension
+		allocation = time.Duration(float64(allocation) / extendedShare)
+	}
+	settings.maximalDuration = allocation
+
+	return settings
+}
+
+// it returns a description of how the move was chosen, if it wasn't chosen
+// by the tree search alone
+func (result searchResult) note() string {
+	switch {
+	case result.fromBook:
+		return "book move"
+	case result.proof != engine.Unproven:
+		return result.proof.String()
+	default:
+		return ""
+	}
+}
+
+func loadBook(path string) (engine.Book, error) {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		return engine.Book{}, fmt.Errorf("unable to read the book: %s", err)
+	}
+
+	book, err := engine.DecodeBook(string(text))
+	if err != nil {
+		return engine.Book{}, fmt.Errorf("unable to decode the book: %s", err)
+	}
+
+	return book, nil
+}
+
+// the opening book is consulted first, then the exact solver is used
+// for small positions; a book move and a proven win are returned
+// without a tree search
 func search(
 	storage models.StoneStorage,
 	color models.Color,
 	settings searchSettings,
-) (models.Move, error) {
+) (searchResult, error) {
 	generator := models.MoveGenerator{}
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(color),
+		Storage: storage,
+	}
+
+	if settings.book != nil {
+		if err := check(storage, color); err != nil {
+			return searchResult{}, err // don't wrap
+		}
+
+		move, ok := settings.book.Lookup(storage, color, rand.Intn)
+		if ok {
+			node := newChosenNode(root, move)
+			return searchResult{node: node, fromBook: true}, nil
+		}
+	}
+
+	proof := engine.Unproven
+	if settings.solverThreshold != 0 &&
+		engine.EmptyPointCount(storage) <= settings.solverThreshold {
+		startTime := time.Now()
+		solverDuration :=
+			solverDurationShare * float64(settings.maximalDuration)
+		solver := engine.Solver{
+			MoveGenerator:    generator,
+			MaximalNodeCount: solverNodeCount,
+			Deadline:         startTime.Add(time.Duration(solverDuration)),
+			Clock:            time.Now,
+		}
+
+		var move models.Move
+		var err error
+		move, proof, err = solver.Solve(storage, color)
+		if err != nil {
+			return searchResult{}, err // don't wrap
+		}
+		if proof == engine.ProvenWin {
+			node := newChosenNode(root, move)
+			return searchResult{node: node, proof: proof}, nil
+		}
+
+		settings.maximalDuration -= time.Since(startTime)
+	}
 
 	randomSelector := selectors.RandomMoveSelector{}
+	hasher := engine.NewZobristHasher(storage.Size())
+	amafTable := engine.NewAMAFTable(hasher)
+	var buildingScorer, finalScorer selectors.NodeScorer
+	switch settings.scorer {
+	case ucbScorer:
+		buildingScorer = scorers.UCBScorer{
+			Factor: settings.ucbFactor,
+		}
+		finalScorer = buildingScorer
+	case ucb1TunedScorer:
+		buildingScorer = engine.UCB1TunedScorer{
+			Factor: settings.ucbFactor,
+		}
+		finalScorer = buildingScorer
+	case winRateScorer:
+		buildingScorer = scorers.UCBScorer{
+			Factor: settings.ucbFactor,
+		}
+		finalScorer = engine.WinRateScorer{}
+	case raveScorer:
+		buildingScorer = engine.RAVEScorer{
+			Table:       amafTable,
+			Factor:      settings.ucbFactor,
+			Equivalence: raveEquivalence,
+		}
+		// All-Moves-As-First statistics are only useful for rarely visited
+		// nodes, so they aren't used for the move selection
+		finalScorer = scorers.UCBScorer{
+			Factor: settings.ucbFactor,
+		}
+	}
 	generalSelector := selectors.MaximalNodeSelector{
-		NodeScorer: scorers.UCBScorer{
-			Factor: ucbFactor,
-		},
+		NodeScorer: buildingScorer,
 	}
 
-	var simulator simulators.Simulator // nolint: staticcheck
-	simulator = simulators.RolloutSimulator{
-		MoveGenerator: generator,
-		MoveSelector:  randomSelector,
-	}
-	if settings.parallelSimulator {
-		simulator = simulators.ParallelSimulator{
-			Simulator:   simulator,
-			Concurrency: runtime.NumCPU(),
+	var rolloutSelector engine.PositionalMoveSelector
+	switch settings.rollout {
+	case randomRollout:
+		rolloutSelector = engine.MoveSelectorAdapter{
+			MoveSelector: randomSelector,
 		}
+	case heuristicRollout:
+		rolloutSelector = engine.HeuristicMoveSelector{}
+	}
+
+	// the pruning of symmetric moves is applied on expanding of the root,
+	// so it works for copies of the root made by the parallel builder too
+	var expandingGenerator simulators.MoveGenerator = generator
+	if settings.symmetry {
+		expandingGenerator = engine.NewSymmetricMoveGenerator(generator, root)
 	}
 
-	var bulkySimulator builders.BulkySimulator
-	if !settings.parallelBulkySimulator {
-		bulkySimulator = bulky.FirstNodeSimulator{
-			Simulator: simulator,
+	var treeBuilder builders.Builder
+	if settings.usesOwnBuilder() {
+		ownBuilder := engine.TreeBuilder{
+			NodeSelector:  generalSelector,
+			MoveGenerator: expandingGenerator,
+			MoveSelector:  rolloutSelector,
+		}
+		if settings.scorer == raveScorer {
+			ownBuilder.AMAFTable = amafTable
 		}
+		if settings.transpositions {
+			ownBuilder.TranspositionTable = engine.NewTranspositionTable(hasher)
+		}
+
+		treeBuilder = ownBuilder
 	} else {
-		bulkySimulator = bulky.AllNodesSimulator{
-			Simulator: simulator,
+		var simulator simulators.Simulator
+		if settings.rollout == randomRollout {
+			simulator = simulators.RolloutSimulator{
+				MoveGenerator: generator,
+				MoveSelector:  randomSelector,
+			}
+		} else {
+			simulator = engine.RolloutSimulator{
+				MoveGenerator: generator,
+				MoveSelector:  rolloutSelector,
+			}
+		}
+		if settings.parallelSimulator {
+			simulator = simulators.ParallelSimulator{
+				Simulator:   simulator,
+				Concurrency: settings.concurrency.Simulator,
+			}
 		}
-	}
 
-	var builder builders.Builder
-	terminator := terminators.NewGroupTerminator(
-		terminators.NewPassTerminator(settings.maximalPass),
-		terminators.NewTimeTerminator(time.Now, settings.maximalDuration),
-	)
-	builder = builders.IterativeBuilder{
-		Builder: builders.TreeBuilder{
+		var bulkySimulator builders.BulkySimulator
+		if !settings.parallelBulkySimulator {
+			bulkySimulator = bulky.FirstNodeSimulator{
+				Simulator: simulator,
+			}
+		} else {
+			bulkySimulator = bulky.AllNodesSimulator{
+				Simulator: simulator,
+			}
+		}
+
+		treeBuilder = builders.TreeBuilder{
 			NodeSelector:  generalSelector,
-			MoveGenerator: generator,
+			MoveGenerator: expandingGenerator,
 			Simulator:     bulkySimulator,
-		},
-		Terminator: terminator,
+		}
+	}
+
+	var builder builders.Builder
+	if settings.adaptiveTime {
+		extension := settings.timeExtension * float64(settings.maximalDuration)
+		adaptiveBuilder := engine.AdaptiveBuilder{
+			Builder:     treeBuilder,
+			Clock:       time.Now,
+			MaximalPass: settings.maximalPass,
+			Duration:    settings.maximalDuration,
+			Extension:   time.Duration(extension),
+			CloseRatio:  closeCandidatesRatio,
+		}
+		if settings.parallelBuilder {
+			// the decisions are made by the merged tree
+			adaptiveBuilder.Progress = engine.NewBuildingProgress()
+		}
+
+		builder = adaptiveBuilder
+		// the building is stopped by game counts, so the best move by them
+		// is played
+		finalScorer = engine.GameCountScorer{}
+	} else {
+		terminator := terminators.NewGroupTerminator(
+			terminators.NewPassTerminator(settings.maximalPass),
+			terminators.NewTimeTerminator(time.Now, settings.maximalDuration),
+		)
+		builder = builders.IterativeBuilder{
+			Builder:    treeBuilder,
+			Terminator: terminator,
+		}
 	}
 	if settings.parallelBuilder {
 		builder = builders.ParallelBuilder{
 			Builder:     builder,
-			Concurrency: runtime.NumCPU(),
+			Concurrency: settings.concurrency.Builder,
 		}
 	}
 
-	root := &tree.Node{
-		Move:    models.NewPreliminaryMove(color),
-		Storage: storage,
-	}
 	searcher := searchers.MoveSearcher{
-		MoveGenerator: generator,
+		MoveGenerator: expandingGenerator,
 		Builder:       builder,
-		NodeSelector:  generalSelector,
+		NodeSelector: selectors.MaximalNodeSelector{
+			NodeScorer: finalScorer,
+		},
 	}
 	node, err := searcher.SearchMove(root)
 	if err != nil {
-		return models.Move{}, err
+		return searchResult{}, err // don't wrap
+	}
+
+	return searchResult{node: node, proof: proof}, nil
+}
+
+// it makes a child of the root for the move chosen without a tree search
+func newChosenNode(root *tree.Node, move models.Move) *tree.Node {
+	node := &tree.Node{
+		Parent:  root,
+		Move:    move,
+		Storage: root.Storage.ApplyMove(move),
+	}
+	root.Children = tree.NodeGroup{node}
+
+	return node
+}
+
+func newGameClocks(control climodels.TimeControl) gameClocks {
+	return gameClocks{
+		models.Black: climodels.NewClock(control),
+		models.White: climodels.NewClock(control),
+	}
+}
+
+// it updates the clock of the color in place
+func (clocks gameClocks) spend(
+	color models.Color,
+	elapsed time.Duration,
+) error {
+	clock, err := clocks[color].Spend(elapsed)
+	clocks[color] = clock
+
+	return err // don't wrap
+}
+
+// it's called for an unfinished move (e.g. on an incorrect input);
+// it updates the clock of the color in place only if the clock has expired,
+// so the move can be continued otherwise
+func (clocks gameClocks) checkTime(
+	color models.Color,
+	elapsed time.Duration,
+) error {
+	if _, err := clocks[color].Spend(elapsed); err == nil {
+		return nil
+	}
+
+	return clocks.spend(color, elapsed) // don't wrap
+}
+
+func (clocks gameClocks) String() string {
+	return fmt.Sprintf(
+		"clocks: %s %v, %s %v",
+		ascii.EncodeColor(models.Black),
+		clocks[models.Black],
+		ascii.EncodeColor(models.White),
+		clocks[models.White],
+	)
+}
+
+func (clocks gameClocks) report() map[string]report.Clock {
+	if clocks == nil {
+		return nil
+	}
+
+	reportedClocks := make(map[string]report.Clock)
+	for color, clock := range clocks {
+		reportedClocks[ascii.EncodeColor(color)] =
+			report.NewClock(clock.MainTime, clock.Periods)
 	}
 
-	return node.Move, nil
+	return reportedClocks
 }
 
 func check(storage models.StoneStorage, color models.Color) error {
@@ -181,19 +723,48 @@ func check(storage models.StoneStorage, color models.Color) error {
 	return err // don't wrap
 }
 
+type displaySettings struct {
+	storageEncoder ascii.StoneStorageEncoder
+	coordinates    coordinates.System
+	// nil value disables marking of groups in atari
+	atariStoneEncoder ascii.StoneEncoder
+	moveMarks         moveMarks
+	markMoves         bool
+	listMoves         bool
+	diffEncoders      ascii.DiffEncoders
+	markChanges       bool
+	// nil value disables marking of changes
+	previousStorage models.StoneStorage
+	// it disables the board and the prompt in favor of writeTurn()
+	structured bool
+	// nil value disables displaying of clocks
+	clocks gameClocks
+}
+
 func writePrompt(
-	storageEncoder ascii.StoneStorageEncoder,
+	display displaySettings,
 	storage models.StoneStorage,
 	color models.Color,
 	side climodels.Side,
 ) error {
-	text := storageEncoder.EncodeStoneStorage(storage)
+	if display.structured {
+		return check(storage, color) // don't wrap
+	}
+
+	text, notes := encodeBoard(display, storage, color)
 	fmt.Println(text)
 
 	if err := check(storage, color); err != nil {
 		return err // don't wrap
 	}
 
+	for _, note := range notes {
+		fmt.Println(note)
+	}
+	if display.clocks != nil {
+		fmt.Println(display.clocks)
+	}
+
 	var mark string
 	if side == climodels.Searcher {
 		mark = "(searching) "
@@ -204,29 +775,188 @@ func writePrompt(
 	return nil
 }
 
+// it writes the game state in JSON in a single line
+func writeTurn(
+	storage models.StoneStorage,
+	color models.Color,
+	lastMove *models.Move,
+	engine *report.Engine,
+	clocks gameClocks,
+) error {
+	gameErr := check(storage, color)
+	if clocks != nil && clocks[color].Expired() {
+		// a loss on time is reported as a usual loss
+		gameErr = models.ErrAlreadyLoss
+	}
+
+	turn := report.NewTurn(storage, color, lastMove, engine, gameErr)
+	turn.Clocks = clocks.report()
+	text, err := turn.Encode()
+	if err != nil {
+		return err // don't wrap
+	}
+
+	fmt.Println(text)
+	return nil
+}
+
+// it returns the encoded board and notes about it (e.g. atari warnings);
+// the specified point encoders take precedence over the display ones
+func encodeBoard(
+	display displaySettings,
+	storage models.StoneStorage,
+	color models.Color,
+	pointEncoders ...ascii.PointEncoder,
+) (text string, notes []string) {
+	if display.markChanges && display.previousStorage != nil {
+		pointEncoders = append(pointEncoders, ascii.NewDiffPointEncoder(
+			display.previousStorage,
+			display.diffEncoders,
+		))
+	}
+
+	var atariGroups []climodels.Group
+	if display.atariStoneEncoder != nil {
+		atariGroups = climodels.FindAtariGroups(storage)
+
+		var atariPoints []models.Point
+		for _, group := range atariGroups {
+			atariPoints = append(atariPoints, group.Points...)
+		}
+		pointEncoders = append(pointEncoders, ascii.NewMarkedStoneEncoder(
+			atariPoints,
+			display.atariStoneEncoder,
+		))
+	}
+
+	var movePoints climodels.MovePoints
+	if display.markMoves || display.listMoves {
+		// the game end is handled below
+		movePoints, _ = climodels.FindMovePoints(storage, color)
+	}
+	if display.markMoves {
+		pointEncoders = append(
+			pointEncoders,
+			ascii.NewMarkedPointEncoder(movePoints.Legal, display.moveMarks.legal),
+			ascii.NewMarkedPointEncoder(
+				movePoints.Illegal,
+				display.moveMarks.illegal,
+			),
+		)
+	}
+
+	text = display.storageEncoder.EncodeStoneStorage(storage, pointEncoders...)
+
+	for _, group := range atariGroups {
+		notes = append(notes, makeAtariWarning(display.coordinates, group))
+	}
+	if display.listMoves {
+		notes = append(
+			notes,
+			makePointList(display.coordinates, "legal moves", movePoints.Legal),
+			makePointList(
+				display.coordinates,
+				"illegal moves",
+				movePoints.Illegal,
+			),
+		)
+	}
+
+	return text, notes
+}
+
 func makePrompt(color models.Color, data interface{}) string {
 	prompt := ascii.EncodeColor(color)
 	return fmt.Sprintf("%s> %v", prompt, data)
 }
 
+func makeAtariWarning(
+	system coordinates.System,
+	group climodels.Group,
+) string {
+	color := ascii.EncodeColor(group.Color)
+	point := system.EncodePoint(group.Points[0])
+	return fmt.Sprintf("%s group at %s is in atari", color, point)
+}
+
+func makePointList(
+	system coordinates.System,
+	title string,
+	points []models.Point,
+) string {
+	encodedPoints := []string{"none"}
+	if len(points) != 0 {
+		encodedPoints = nil
+		for _, point := range points {
+			encodedPoints = append(encodedPoints, system.EncodePoint(point))
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", title, strings.Join(encodedPoints, ", "))
+}
+
+// it's a result of reading of a line in the background
+type inputLine struct {
+	text string
+	err  error
+}
+
+// it reads lines in the background, so waiting for them can be limited
+// by time; the channel is closed after a reading error
+func readLines(reader *bufio.Reader) <-chan inputLine {
+	lines := make(chan inputLine)
+	go func() {
+		defer close(lines)
+
+		for {
+			text, err := reader.ReadString('\n')
+			lines <- inputLine{text: text, err: err}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return lines
+}
+
+// it returns climodels.ErrTimeIsOver, if there is no input until the timeout
 func readMove(
-	reader *bufio.Reader,
-	storageEncoder ascii.StoneStorageEncoder,
+	lines <-chan inputLine,
+	timeout <-chan time.Time,
+	display displaySettings,
 	storage models.StoneStorage,
 	color models.Color,
 	side climodels.Side,
 ) (models.Move, error) {
-	if err := writePrompt(storageEncoder, storage, color, side); err != nil {
+	if err := writePrompt(display, storage, color, side); err != nil {
 		return models.Move{}, err // don't wrap
 	}
 
-	text, err := reader.ReadString('\n')
-	if err != nil && err != io.EOF {
-		return models.Move{}, fmt.Errorf("unable to read the move: %s", err)
+	// after the channel closing, the line is empty as after the end of input
+	var line inputLine
+	select {
+	case line = <-lines:
+	case <-timeout:
+		return models.Move{}, climodels.ErrTimeIsOver
+	}
+	if line.err != nil && line.err != io.EOF {
+		return models.Move{}, fmt.Errorf(
+			"unable to read the move: %s",
+			line.err,
+		)
+	}
+
+	text := strings.TrimSuffix(line.text, "\n")
+	if text == movesCommand {
+		movesDisplay := display
+		movesDisplay.markMoves = true
+		movesDisplay.listMoves = true
+
+		return readMove(lines, timeout, movesDisplay, storage, color, side)
 	}
 
-	text = strings.TrimSuffix(text, "\n")
-	point, err := sgf.DecodePoint(text)
+	point, err := display.coordinates.DecodePoint(text)
 	if err != nil {
 		return models.Move{}, fmt.Errorf("unable to decode the point: %s", err)
 	}
@@ -243,14 +973,14 @@ func readMove(
 }
 
 func searchMove(
-	storageEncoder ascii.StoneStorageEncoder,
+	display displaySettings,
 	storage models.StoneStorage,
 	color models.Color,
 	side climodels.Side,
 	settings searchSettings,
-) (models.Move, error) {
-	if err := writePrompt(storageEncoder, storage, color, side); err != nil {
-		return models.Move{}, err // don't wrap
+) (searchResult, error) {
+	if err := writePrompt(display, storage, color, side); err != nil {
+		return searchResult{}, err // don't wrap
 	}
 
 	return search(storage, color, settings)
@@ -259,6 +989,21 @@ func searchMove(
 func main() {
 	rand.Seed(time.Now().UnixNano())
 
+	if len(os.Args) > 1 && os.Args[1] == analyzeCommand {
+		if err := runAnalyze(os.Args[2:]); err != nil {
+			log.Fatal("unable to analyze the position: ", err)
+		}
+
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == bookCommand {
+		if err := runBook(os.Args[2:]); err != nil {
+			log.Fatal("unable to process the book: ", err)
+		}
+
+		return
+	}
+
 	storageInSGF := flag.String(
 		"sgf",
 		"",
@@ -269,38 +1014,118 @@ func main() {
 		"random",
 		"human color (allowed: random, black, white)",
 	)
-	passes := flag.Int("passes", 1000, "building passes")
-	duration := flag.Duration(
-		"duration",
-		10*time.Second,
-		"building duration (e.g. 72h3m0.5s)",
+	searchFlags := newSearchFlags(flag.CommandLine)
+	capabilities := terminal.DetectCapabilities(
+		terminal.IsTerminal(os.Stdout),
+		os.LookupEnv,
+	)
+	useUnicode := flag.Bool(
+		"unicode",
+		capabilities.Unicode,
+		"use Unicode to display stones (auto-detected by the locale)",
+	)
+	blackStone := flag.String(
+		"blackStone",
+		"",
+		"text for displaying black stones (e.g. an emoji; overrides -unicode)",
+	)
+	whiteStone := flag.String(
+		"whiteStone",
+		"",
+		"text for displaying white stones (e.g. an emoji; overrides -unicode)",
+	)
+	colorful := flag.Bool(
+		"colorful",
+		capabilities.Colors,
+		"use colors to display the board "+
+			"(auto-detected by the terminal, NO_COLOR and TERM)",
+	)
+	themeName := flag.String(
+		"theme",
+		"classic",
+		"color theme (allowed: classic, wood, night)",
+	)
+	blackColor := flag.String(
+		"blackColor",
+		"",
+		"color of black stones (overrides the theme; "+
+			"allowed: SGR parameter N, 256-color index 256:N, RGB color #RRGGBB)",
+	)
+	whiteColor := flag.String(
+		"whiteColor",
+		"",
+		"color of white stones (overrides the theme; the same format)",
+	)
+	atariColor := flag.String(
+		"atariColor",
+		"",
+		"color of stones in atari (overrides the theme; the same format)",
+	)
+	gridColor := flag.String(
+		"gridColor",
+		"",
+		"color of the board grid (overrides the theme; the same format)",
+	)
+	legendColor := flag.String(
+		"legendColor",
+		"",
+		"color of the board legend (overrides the theme; the same format)",
+	)
+	boardColor := flag.String(
+		"boardColor",
+		"",
+		"background color of the board (overrides the theme; the same format)",
 	)
-	parallelSimulator := flag.Bool(
-		"parallelSimulator",
+	atari := flag.Bool("atari", true, "mark groups in atari")
+	markMoves := flag.Bool(
+		"moves",
 		false,
-		"use parallel game simulating of a single node child",
+		"mark legal and illegal moves (also available by the \"moves\" command)",
 	)
-	parallelBulkySimulator := flag.Bool(
-		"parallelBulkySimulator",
+	markChanges := flag.Bool(
+		"diff",
 		false,
-		"use parallel game simulating of all node children",
+		"mark stones added and removed by the last move",
+	)
+	format := flag.String(
+		"format",
+		textFormat,
+		"output format (allowed: text, json; json emits a JSON object per turn)",
 	)
-	parallelBuilder := flag.Bool(
-		"parallelBuilder",
+	timeControl := flag.String(
+		"timeControl",
+		"",
+		"time control for both players (e.g. absolute:5m, fischer:5m+10s, "+
+			"byoyomi:5m+3x30s; default: none)",
+	)
+	tui := flag.Bool(
+		"tui",
+		false,
+		"use the full-screen terminal interface with cursor-based move entry",
+	)
+	mouse := flag.Bool(
+		"mouse",
 		true,
-		"use parallel tree building",
+		"place stones by mouse clicks in the full-screen terminal interface",
 	)
-	useUnicode := flag.Bool("unicode", true, "use Unicode to display stones")
-	colorful := flag.Bool("colorful", true, "use colors to display stones")
-	blackColor := flag.Int(
-		"blackColor",
-		34, // blue
-		"SGR parameter for ANSI escape sequences for setting a color of black stones",
+	coordinateSystem := flag.String(
+		"coordinates",
+		"sgf",
+		"coordinate system for moves and the board legend "+
+			"(allowed: sgf, gtp, numeric)",
 	)
-	whiteColor := flag.Int(
-		"whiteColor",
-		31, // red
-		"SGR parameter for ANSI escape sequences for setting a color of white stones",
+	legend := flag.String(
+		"legend",
+		"left,bottom",
+		"comma-separated sides of the board legend "+
+			"(allowed: left, right, top, bottom)",
+	)
+	stars := flag.Bool("stars", true, "display star points")
+	starPoints := flag.String(
+		"starPoints",
+		"",
+		"space-separated star points in the coordinate system "+
+			"(default: conventional ones for 9x9, 13x13 and 19x19 boards)",
 	)
 	wide := flag.Bool("wide", true, "display the board wide")
 	grid := flag.Bool("grid", true, "display the board grid")
@@ -324,32 +1149,178 @@ func main() {
 		log.Fatal("unable to decode the color: ", err)
 	}
 
-	var stoneEncoder ascii.StoneEncoder
+	if *format != textFormat && *format != jsonFormat {
+		log.Fatal("unable to decode the output format: ", *format)
+	}
+	if *format == jsonFormat && *tui {
+		log.Fatal(
+			"the JSON output format is incompatible with the terminal interface",
+		)
+	}
+
+	var clocks gameClocks
+	if *timeControl != "" {
+		control, err := climodels.DecodeTimeControl(*timeControl)
+		if err != nil {
+			log.Fatal("unable to decode the time control: ", err)
+		}
+
+		clocks = newGameClocks(control)
+	}
+
+	parsedCoordinateSystem, err := coordinates.DecodeSystem(*coordinateSystem)
+	if err != nil {
+		log.Fatal("unable to decode the coordinate system: ", err)
+	}
+
+	legendPlacement, err := ascii.DecodeLegendPlacement(*legend)
+	if err != nil {
+		log.Fatal("unable to decode the legend placement: ", err)
+	}
+
+	parsedStarPoints := climodels.StarPoints(storage.Size())
+	if *starPoints != "" {
+		parsedStarPoints = nil
+		for _, text := range strings.Fields(*starPoints) {
+			point, err := parsedCoordinateSystem.DecodePoint(text)
+			if err != nil {
+				log.Fatal("unable to decode the star point: ", err)
+			}
+
+			parsedStarPoints = append(parsedStarPoints, point)
+		}
+	}
+
+	theme, err := ansi.DecodeTheme(*themeName)
+	if err != nil {
+		log.Fatal("unable to decode the theme: ", err)
+	}
+	for _, override := range []struct {
+		text  string
+		color *ansi.Color
+	}{
+		{*blackColor, &theme.BlackStone},
+		{*whiteColor, &theme.WhiteStone},
+		{*atariColor, &theme.Highlight},
+		{*gridColor, &theme.Grid},
+		{*legendColor, &theme.Legend},
+		{*boardColor, &theme.Board},
+	} {
+		if override.text == "" {
+			continue
+		}
+
+		color, err := ansi.DecodeColor(override.text)
+		if err != nil {
+			log.Fatal("unable to decode the theme color: ", err)
+		}
+
+		*override.color = color
+	}
+
+	var stoneEncoder, atariStoneEncoder ascii.StoneEncoder
 	var placeholders ascii.Placeholders
+	var marks moveMarks
+	var removedStoneMark string
+	var addedStoneMarks stoneMarks
 	if *useUnicode {
 		stoneEncoder = unicode.EncodeStone
+		atariStoneEncoder = unicode.EncodeMarkedStone
 		placeholders = unicodePlaceholders
+		marks = unicodeMoveMarks
+		removedStoneMark = unicodeRemovedStoneMark
+		addedStoneMarks = unicodeAddedStoneMarks
 	} else {
 		stoneEncoder = func(color models.Color) string {
 			return string(sgf.EncodeColor(color))
 		}
+		atariStoneEncoder = func(color models.Color) string {
+			return strings.ToLower(string(sgf.EncodeColor(color)))
+		}
 		placeholders = asciiPlaceholders
+		marks = asciiMoveMarks
+		removedStoneMark = asciiRemovedStoneMark
+		addedStoneMarks = asciiAddedStoneMarks
 	}
-	if *colorful {
+	customStone := func(color models.Color) (string, bool) {
+		switch {
+		case color == models.Black && *blackStone != "":
+			return *blackStone, true
+		case color == models.White && *whiteStone != "":
+			return *whiteStone, true
+		default:
+			return "", false
+		}
+	}
+	if *blackStone != "" || *whiteStone != "" {
 		baseStoneEncoder := stoneEncoder
 		stoneEncoder = func(color models.Color) string {
-			text := baseStoneEncoder(color)
-			return colorize(text, color, colorCodeGroup{
-				models.Black: *blackColor,
-				models.White: *whiteColor,
-			})
+			if text, ok := customStone(color); ok {
+				return text
+			}
+
+			return baseStoneEncoder(color)
+		}
+
+		// custom stones are marked in atari only by colors
+		baseAtariStoneEncoder := atariStoneEncoder
+		atariStoneEncoder = func(color models.Color) string {
+			if text, ok := customStone(color); ok {
+				return text
+			}
+
+			return baseAtariStoneEncoder(color)
 		}
 	}
+	if *colorful {
+		removedStoneMark = theme.Highlight.Foreground(removedStoneMark)
+	}
 	if !*grid {
 		placeholders.HorizontalLine = " "
 		placeholders.VerticalLine = " "
 	}
 
+	encoderOptions := []ascii.StoneStorageEncoderOption{
+		ascii.WithAxisEncoder(parsedCoordinateSystem),
+	}
+	if *colorful {
+		baseStoneEncoder := stoneEncoder
+		stoneEncoder = func(color models.Color) string {
+			text := baseStoneEncoder(color)
+			return theme.StoneColor(color).Foreground(text)
+		}
+
+		baseAtariStoneEncoder := atariStoneEncoder
+		atariStoneEncoder = func(color models.Color) string {
+			text := theme.Highlight.Foreground(baseAtariStoneEncoder(color))
+			if _, ok := customStone(color); ok {
+				// custom stones (e.g. emojis) may ignore foreground colors
+				text = ansi.Inverse(text)
+			}
+
+			return text
+		}
+
+		placeholders.HorizontalLine =
+			theme.Grid.Foreground(placeholders.HorizontalLine)
+		placeholders.VerticalLine = theme.Grid.Foreground(placeholders.VerticalLine)
+		placeholders.Crosshairs = theme.Grid.Foreground(placeholders.Crosshairs)
+		placeholders.StarPoint = theme.Grid.Foreground(placeholders.StarPoint)
+
+		encoderOptions = append(
+			encoderOptions,
+			ascii.WithLegendEncoder(theme.Legend.Foreground),
+			ascii.WithLineEncoder(theme.Board.Background),
+		)
+	}
+	if !*atari {
+		atariStoneEncoder = nil
+	}
+	if *stars {
+		encoderOptions =
+			append(encoderOptions, ascii.WithStarPoints(parsedStarPoints))
+	}
+
 	var margins ascii.Margins
 	if *wide {
 		margins = baseWideMargins
@@ -360,44 +1331,157 @@ func main() {
 			margins.Stone = wideStoneMargins
 		}
 	}
+	margins.Legend.Placement = legendPlacement
 
 	side := climodels.NewSide(parsedHumanColor)
-	reader := bufio.NewReader(os.Stdin)
-	storageEncoder := ascii.NewStoneStorageEncoder(
-		stoneEncoder,
-		placeholders,
-		margins,
-		1,
-	)
-	settings := searchSettings{
-		maximalPass:            *passes,
-		maximalDuration:        *duration,
-		parallelSimulator:      *parallelSimulator,
-		parallelBulkySimulator: *parallelBulkySimulator,
-		parallelBuilder:        *parallelBuilder,
+	display := displaySettings{
+		storageEncoder: ascii.NewStoneStorageEncoder(
+			stoneEncoder,
+			placeholders,
+			margins,
+			1,
+			encoderOptions...,
+		),
+		coordinates:       parsedCoordinateSystem,
+		atariStoneEncoder: atariStoneEncoder,
+		moveMarks:         marks,
+		markMoves:         *markMoves,
+		markChanges:       *markChanges,
+		structured:        *format == jsonFormat,
+		clocks:            clocks,
+	}
+	display.diffEncoders = ascii.DiffEncoders{
+		Added: func(color models.Color) string {
+			// escape sequences are used only together with colors
+			if !*colorful {
+				return addedStoneMarks.encode(color)
+			}
+
+			return ansi.Underline(stoneEncoder(color))
+		},
+		Removed: func(color models.Color) string {
+			return removedStoneMark
+		},
+	}
+	settings, err := searchFlags.settings()
+	if err != nil {
+		log.Fatal("unable to decode the search settings: ", err)
+	}
+	runtime.GOMAXPROCS(settings.concurrency.Threads)
+	if *tui {
+		err := runTUI(display, storage, parsedHumanColor, settings, *mouse)
+		if err != nil {
+			log.Fatal("unable to run the terminal interface: ", err)
+		}
+
+		return
+	}
+
+	if display.structured {
+		var initialColor models.Color
+		if side == climodels.Human {
+			initialColor = parsedHumanColor
+		} else {
+			initialColor = parsedHumanColor.Negative()
+		}
+
+		err := writeTurn(storage, initialColor, nil, nil, clocks)
+		if err != nil {
+			log.Fatal("unable to write the turn: ", err)
+		}
 	}
+
+	lines := readLines(bufio.NewReader(os.Stdin))
+	turnStartTime := time.Now()
 loop:
 	for {
 		var currentColor models.Color
 		var move models.Move
+		var engineStats *report.Engine
 		var err error
 		switch side {
 		case climodels.Human:
 			currentColor = parsedHumanColor
-			move, err = readMove(reader, storageEncoder, storage, currentColor, side)
+
+			// the human loses on time without waiting for an input
+			var timeout <-chan time.Time
+			var timer *time.Timer
+			if clocks != nil {
+				remaining := clocks[currentColor].Remaining() -
+					time.Since(turnStartTime)
+				timer = time.NewTimer(remaining)
+				timeout = timer.C
+			}
+
+			move, err =
+				readMove(lines, timeout, display, storage, currentColor, side)
+			if timer != nil {
+				timer.Stop()
+			}
 		case climodels.Searcher:
 			currentColor = parsedHumanColor.Negative()
-			move, err = searchMove(storageEncoder, storage, currentColor, side, settings)
+
+			moveSettings := settings
+			if clocks != nil {
+				moveSettings = settings.withClock(clocks[currentColor], storage)
+			}
+
+			var result searchResult
+			startTime := time.Now()
+			result, err =
+				searchMove(display, storage, currentColor, side, moveSettings)
 			if err == nil {
-				text := sgf.EncodePoint(move.Point)
-				fmt.Println(text)
+				move = result.node.Move
+
+				stats := report.NewEngine(
+					result.node.State.GameCount,
+					result.node.State.WinCount,
+					time.Since(startTime),
+				)
+				if result.proof != engine.Unproven {
+					stats.Proof = result.proof.String()
+				}
+				stats.Book = result.fromBook
+				engineStats = &stats
+
+				if !display.structured {
+					text := display.coordinates.EncodePoint(move.Point)
+					if note := result.note(); note != "" {
+						text += fmt.Sprintf(" (%s)", note)
+					}
+
+					fmt.Println(text)
+				}
+			}
+		}
+		if clocks != nil {
+			switch err {
+			case nil:
+				err = clocks.spend(currentColor, time.Since(turnStartTime))
+			case models.ErrAlreadyLoss, models.ErrAlreadyWin:
+			default:
+				// the clock runs on incorrect inputs too
+				elapsed := time.Since(turnStartTime)
+				timeErr := clocks.checkTime(currentColor, elapsed)
+				if timeErr != nil {
+					err = timeErr
+				}
 			}
 		}
 		switch err {
 		case nil:
-		case models.ErrAlreadyLoss, models.ErrAlreadyWin:
-			prompt := makePrompt(currentColor, err)
-			fmt.Println(prompt)
+		case models.ErrAlreadyLoss,
+			models.ErrAlreadyWin,
+			climodels.ErrTimeIsOver:
+			if !display.structured {
+				prompt := makePrompt(currentColor, err)
+				fmt.Println(prompt)
+			} else if err == climodels.ErrTimeIsOver {
+				err := writeTurn(storage, currentColor, nil, nil, clocks)
+				if err != nil {
+					log.Fatal("unable to write the turn: ", err)
+				}
+			}
 
 			break loop
 		default:
@@ -405,7 +1489,22 @@ loop:
 			continue loop
 		}
 
+		display.previousStorage = storage
 		storage = storage.ApplyMove(move)
 		side = side.Invert()
+		turnStartTime = time.Now()
+
+		if display.structured {
+			err := writeTurn(
+				storage,
+				currentColor.Negative(),
+				&move,
+				engineStats,
+				clocks,
+			)
+			if err != nil {
+				log.Fatal("unable to write the turn: ", err)
+			}
+		}
 	}
 }
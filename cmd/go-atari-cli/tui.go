@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ansi"
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
+	climodels "github.com/thewizardplusplus/go-atari-cli/models"
+	"github.com/thewizardplusplus/go-atari-cli/terminal"
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+const (
+	tuiHistoryLength = 10
+	tuiPanelGap      = "   "
+	tuiHelp          = "arrows: move the cursor, enter or a mouse click: " +
+		"place a stone, q: quit"
+)
+
+type tuiState struct {
+	storage         models.StoneStorage
+	previousStorage models.StoneStorage
+	cursor          models.Point
+	history         []models.Move
+	status          string
+}
+
+func (state tuiState) applyMove(move models.Move) tuiState {
+	state.previousStorage = state.storage
+	state.storage = state.storage.ApplyMove(move)
+	state.history = append(state.history, move)
+	return state
+}
+
+func runTUI(
+	display displaySettings,
+	storage models.StoneStorage,
+	humanColor models.Color,
+	settings searchSettings,
+	mouse bool,
+) error {
+	restore, err := terminal.EnableRawMode()
+	if err != nil {
+		return err // don't wrap
+	}
+	defer func() {
+		if err := restore(); err != nil {
+			log.Print("error: ", err)
+		}
+	}()
+
+	fmt.Print(terminal.EnterAlternateScreen + terminal.HideCursor)
+	defer fmt.Print(terminal.ShowCursor + terminal.ExitAlternateScreen)
+
+	if mouse {
+		fmt.Print(terminal.EnableMouse)
+		defer fmt.Print(terminal.DisableMouse)
+	}
+
+	events := readEvents(bufio.NewReader(os.Stdin))
+	side := climodels.NewSide(humanColor)
+	state := tuiState{
+		storage: storage,
+		cursor: models.Point{
+			Column: storage.Size().Width / 2,
+			Row:    storage.Size().Height / 2,
+		},
+	}
+	turnStartTime := time.Now()
+	for {
+		color := humanColor
+		if side == climodels.Searcher {
+			color = humanColor.Negative()
+		}
+
+		if err := check(state.storage, color); err != nil {
+			return finishTUIGame(events, display, state, color, err)
+		}
+
+		switch side {
+		case climodels.Searcher:
+			state.status = "searching..."
+			drawTUI(display, state, color)
+
+			moveSettings := settings
+			if display.clocks != nil {
+				moveSettings =
+					settings.withClock(display.clocks[color], state.storage)
+			}
+
+			result, err := search(state.storage, color, moveSettings)
+			if err != nil {
+				return err // don't wrap
+			}
+
+			move := result.node.Move
+			if display.clocks != nil {
+				err := display.clocks.spend(color, time.Since(turnStartTime))
+				if err != nil {
+					return finishTUIGame(events, display, state, color, err)
+				}
+			}
+
+			state = state.applyMove(move)
+			state.status =
+				"engine move: " + display.coordinates.EncodePoint(move.Point)
+			if note := result.note(); note != "" {
+				state.status += fmt.Sprintf(" (%s)", note)
+			}
+		case climodels.Human:
+			drawTUI(display, state, color)
+
+			// the human loses on time without waiting for an event
+			var timeout <-chan time.Time
+			var timer *time.Timer
+			if display.clocks != nil {
+				remaining := display.clocks[color].Remaining() -
+					time.Since(turnStartTime)
+				timer = time.NewTimer(remaining)
+				timeout = timer.C
+			}
+
+			var input inputEvent
+			select {
+			case input = <-events:
+				if timer != nil {
+					timer.Stop()
+				}
+			case <-timeout:
+				elapsed := time.Since(turnStartTime)
+				if err := display.clocks.checkTime(color, elapsed); err != nil {
+					return finishTUIGame(events, display, state, color, err)
+				}
+
+				continue
+			}
+			if input.err != nil {
+				return fmt.Errorf("unable to read the event: %s", input.err)
+			}
+
+			event := input.event
+			// the clock runs on any events, including incorrect moves
+			if display.clocks != nil && event.Key != terminal.QuitKey {
+				elapsed := time.Since(turnStartTime)
+				if err := display.clocks.checkTime(color, elapsed); err != nil {
+					return finishTUIGame(events, display, state, color, err)
+				}
+			}
+
+			switch event.Key {
+			case terminal.UpKey, terminal.DownKey, terminal.LeftKey, terminal.RightKey:
+				state.cursor =
+					moveCursor(state.cursor, event.Key, state.storage.Size())
+				continue
+			case terminal.MouseClickKey, terminal.EnterKey:
+				if event.Key == terminal.MouseClickKey {
+					// the board is drawn from the top left corner of the screen
+					point, ok := display.storageEncoder.LocatePoint(
+						state.storage.Size(),
+						event.Column,
+						event.Row,
+					)
+					if !ok {
+						continue
+					}
+
+					state.cursor = point
+				}
+
+				move := models.Move{
+					Color: color,
+					Point: state.cursor,
+				}
+				if err := state.storage.CheckMove(move); err != nil {
+					state.status = fmt.Sprintf("incorrect move: %s", err)
+					continue
+				}
+
+				if display.clocks != nil {
+					elapsed := time.Since(turnStartTime)
+					if err := display.clocks.spend(color, elapsed); err != nil {
+						return finishTUIGame(events, display, state, color, err)
+					}
+				}
+
+				state = state.applyMove(move)
+				state.status = ""
+			case terminal.QuitKey:
+				return nil
+			default:
+				continue
+			}
+		}
+
+		side = side.Invert()
+		turnStartTime = time.Now()
+	}
+}
+
+// it displays the game result and waits for quitting
+func finishTUIGame(
+	events <-chan inputEvent,
+	display displaySettings,
+	state tuiState,
+	color models.Color,
+	gameErr error,
+) error {
+	state.status = makePrompt(color, gameErr)
+	drawTUI(display, state, color)
+
+	return waitTUIQuit(events)
+}
+
+func waitTUIQuit(events <-chan inputEvent) error {
+	for input := range events {
+		if input.err != nil {
+			return fmt.Errorf("unable to read the event: %s", input.err)
+		}
+
+		if input.event.Key == terminal.QuitKey {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// it's a result of reading of an event in the background
+type inputEvent struct {
+	event terminal.Event
+	err   error
+}
+
+// it reads events in the background, so waiting for them can be limited
+// by time; reading stops after an error
+func readEvents(reader *bufio.Reader) <-chan inputEvent {
+	events := make(chan inputEvent)
+	go func() {
+		for {
+			event, err := terminal.ReadEvent(reader)
+			events <- inputEvent{event: event, err: err}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+func moveCursor(
+	cursor models.Point,
+	key terminal.Key,
+	size models.Size,
+) models.Point {
+	switch key {
+	case terminal.UpKey:
+		cursor.Row = min(cursor.Row+1, size.Height-1)
+	case terminal.DownKey:
+		cursor.Row = max(cursor.Row-1, 0)
+	case terminal.RightKey:
+		cursor.Column = min(cursor.Column+1, size.Width-1)
+	case terminal.LeftKey:
+		cursor.Column = max(cursor.Column-1, 0)
+	}
+
+	return cursor
+}
+
+func drawTUI(display displaySettings, state tuiState, color models.Color) {
+	cursorEncoder := func(
+		storage models.StoneStorage,
+		point models.Point,
+	) (text string, ok bool) {
+		if point != state.cursor {
+			return "", false
+		}
+
+		text = display.storageEncoder.EncodePoint(storage, point)
+		return ansi.Inverse(text), true
+	}
+	display.previousStorage = state.previousStorage
+	text, notes := encodeBoard(display, state.storage, color, cursorEncoder)
+
+	panel := []string{"move history:"}
+	firstMove := max(len(state.history)-tuiHistoryLength, 0)
+	for index, move := range state.history[firstMove:] {
+		panel = append(panel, fmt.Sprintf(
+			"%3d. %s %s",
+			firstMove+index+1,
+			ascii.EncodeColor(move.Color),
+			display.coordinates.EncodePoint(move.Point),
+		))
+	}
+	panel = append(panel, "", "to move: "+ascii.EncodeColor(color))
+	if display.clocks != nil {
+		panel = append(panel, display.clocks.String())
+	}
+	if state.status != "" {
+		panel = append(panel, state.status)
+	}
+	panel = append(panel, notes...)
+
+	layout := ascii.JoinHorizontally(
+		tuiPanelGap,
+		ascii.Block{Text: text},
+		ascii.Block{Text: strings.Join(panel, "\n")},
+	)
+	lines := append(strings.Split(layout, "\n"), "", tuiHelp)
+
+	fmt.Print(terminal.ClearScreen + strings.Join(lines, terminal.RawLineBreak))
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,193 @@
+package ansi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ColorMode ...
+type ColorMode int
+
+// ...
+const (
+	NoColor ColorMode = iota
+	BasicColor
+	IndexedColor
+	RGBColor
+)
+
+// Color ...
+type Color struct {
+	Mode ColorMode
+	// SGR parameter of a foreground color for the basic mode
+	// or an index in the 256-color palette for the indexed mode
+	Code  int
+	Red   uint8
+	Green uint8
+	Blue  uint8
+}
+
+// DecodeColor ...
+//
+// It supports the following formats:
+//   - empty string: no color;
+//   - "N": SGR parameter of a foreground color (e.g. "34") or a text
+//     attribute (e.g. "1" for bold);
+//   - "256:N": index in the 256-color palette (e.g. "256:208");
+//   - "#RRGGBB": 24-bit RGB color (e.g. "#dcb35c").
+//
+func DecodeColor(text string) (Color, error) {
+	switch {
+	case text == "":
+		return Color{}, nil
+	case strings.HasPrefix(text, "256:"):
+		index, err := strconv.ParseUint(strings.TrimPrefix(text, "256:"), 10, 8)
+		if err != nil {
+			return Color{}, fmt.Errorf("incorrect color index: %s", err)
+		}
+
+		return Color{Mode: IndexedColor, Code: int(index)}, nil
+	case strings.HasPrefix(text, "#"):
+		components := strings.TrimPrefix(text, "#")
+		if len(components) != 6 {
+			return Color{}, errors.New("incorrect length of the RGB color")
+		}
+
+		rgb, err := strconv.ParseUint(components, 16, 32)
+		if err != nil {
+			return Color{}, fmt.Errorf("incorrect RGB color: %s", err)
+		}
+
+		return Color{
+			Mode:  RGBColor,
+			Red:   uint8(rgb >> 16),
+			Green: uint8(rgb >> 8),
+			Blue:  uint8(rgb),
+		}, nil
+	default:
+		code, err := strconv.ParseUint(text, 10, 8)
+		if err != nil {
+			return Color{}, fmt.Errorf("incorrect SGR parameter: %s", err)
+		}
+		if _, ok := resetCode(int(code)); !isBasicColorCode(int(code)) && !ok {
+			return Color{}, fmt.Errorf("unsupported SGR parameter %d", code)
+		}
+
+		return Color{Mode: BasicColor, Code: int(code)}, nil
+	}
+}
+
+// Foreground ...
+//
+// It sets the color as a foreground one for the text. It resets only
+// the foreground color or the attribute after the text, so the background
+// is kept. Unsupported SGR parameters are ignored.
+//
+func (color Color) Foreground(text string) string {
+	var mode, resetMode string
+	switch color.Mode {
+	case NoColor:
+		return text
+	case BasicColor:
+		mode = strconv.Itoa(color.Code)
+		if isBasicColorCode(color.Code) {
+			resetMode = "39"
+		} else {
+			var ok bool
+			if resetMode, ok = resetCode(color.Code); !ok {
+				return text
+			}
+		}
+	case IndexedColor:
+		mode, resetMode = fmt.Sprintf("38;5;%d", color.Code), "39"
+	case RGBColor:
+		mode, resetMode = "38;2;"+color.rgb(), "39"
+	}
+
+	return setTTYMode(mode) + text + setTTYMode(resetMode)
+}
+
+// Background ...
+//
+// It sets the color as a background one for the text. It resets only
+// the background color or the attribute after the text. Unsupported SGR
+// parameters are ignored.
+//
+func (color Color) Background(text string) string {
+	var mode, resetMode string
+	switch color.Mode {
+	case NoColor:
+		return text
+	case BasicColor:
+		if isBasicColorCode(color.Code) {
+			// background codes are shifted by 10 relative to foreground ones
+			mode, resetMode = strconv.Itoa(color.Code+10), "49"
+		} else {
+			var ok bool
+			if resetMode, ok = resetCode(color.Code); !ok {
+				return text
+			}
+
+			mode = strconv.Itoa(color.Code)
+		}
+	case IndexedColor:
+		mode, resetMode = fmt.Sprintf("48;5;%d", color.Code), "49"
+	case RGBColor:
+		mode, resetMode = "48;2;"+color.rgb(), "49"
+	}
+
+	return setTTYMode(mode) + text + setTTYMode(resetMode)
+}
+
+// Inverse ...
+//
+// It swaps foreground and background colors for the text.
+//
+func Inverse(text string) string {
+	return setTTYMode("7") + text + setTTYMode("27")
+}
+
+// Underline ...
+//
+// It underlines the text.
+//
+func Underline(text string) string {
+	return setTTYMode("4") + text + setTTYMode("24")
+}
+
+func (color Color) rgb() string {
+	return fmt.Sprintf("%d;%d;%d", color.Red, color.Green, color.Blue)
+}
+
+func isBasicColorCode(code int) bool {
+	return (code >= 30 && code <= 37) || (code >= 90 && code <= 97)
+}
+
+// it returns the SGR parameter that resets only the text attribute set
+// by the code, so other attributes and colors are kept
+func resetCode(code int) (string, bool) {
+	switch code {
+	case 1, 2: // bold and faint
+		return "22", true
+	case 3: // italic
+		return "23", true
+	case 4: // underline
+		return "24", true
+	case 5, 6: // slow and rapid blinks
+		return "25", true
+	case 7: // inverse
+		return "27", true
+	case 8: // hidden
+		return "28", true
+	case 9: // strikethrough
+		return "29", true
+	default:
+		return "", false
+	}
+}
+
+func setTTYMode(mode string) string {
+	return fmt.Sprintf("\x1b[%sm", mode)
+}
@@ -0,0 +1,190 @@
+package ansi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeColor(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    Color
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{""},
+			want:    Color{},
+			wantErr: false,
+		},
+		{
+			args:    args{"34"},
+			want:    Color{Mode: BasicColor, Code: 34},
+			wantErr: false,
+		},
+		{
+			args:    args{"256:208"},
+			want:    Color{Mode: IndexedColor, Code: 208},
+			wantErr: false,
+		},
+		{
+			args:    args{"#dcb35c"},
+			want:    Color{Mode: RGBColor, Red: 220, Green: 179, Blue: 92},
+			wantErr: false,
+		},
+		{
+			args:    args{"1"},
+			want:    Color{Mode: BasicColor, Code: 1},
+			wantErr: false,
+		},
+		{
+			args:    args{"0"},
+			want:    Color{},
+			wantErr: true,
+		},
+		{
+			args:    args{"blue"},
+			want:    Color{},
+			wantErr: true,
+		},
+		{
+			args:    args{"256:300"},
+			want:    Color{},
+			wantErr: true,
+		},
+		{
+			args:    args{"#dcb35"},
+			want:    Color{},
+			wantErr: true,
+		},
+		{
+			args:    args{"#dcb35z"},
+			want:    Color{},
+			wantErr: true,
+		},
+	} {
+		got, gotErr := DecodeColor(data.args.text)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestColorForeground(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		color Color
+		args  args
+		want  string
+	}
+
+	for _, data := range []data{
+		{
+			color: Color{},
+			args:  args{"text"},
+			want:  "text",
+		},
+		{
+			color: Color{Mode: BasicColor, Code: 34},
+			args:  args{"text"},
+			want:  "\x1b[34mtext\x1b[39m",
+		},
+		{
+			color: Color{Mode: BasicColor, Code: 1},
+			args:  args{"text"},
+			want:  "\x1b[1mtext\x1b[22m",
+		},
+		{
+			color: Color{Mode: BasicColor, Code: 38},
+			args:  args{"text"},
+			want:  "text",
+		},
+		{
+			color: Color{Mode: IndexedColor, Code: 208},
+			args:  args{"text"},
+			want:  "\x1b[38;5;208mtext\x1b[39m",
+		},
+		{
+			color: Color{Mode: RGBColor, Red: 1, Green: 2, Blue: 3},
+			args:  args{"text"},
+			want:  "\x1b[38;2;1;2;3mtext\x1b[39m",
+		},
+	} {
+		got := data.color.Foreground(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestColorBackground(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		color Color
+		args  args
+		want  string
+	}
+
+	for _, data := range []data{
+		{
+			color: Color{},
+			args:  args{"text"},
+			want:  "text",
+		},
+		{
+			color: Color{Mode: BasicColor, Code: 93},
+			args:  args{"text"},
+			want:  "\x1b[103mtext\x1b[49m",
+		},
+		{
+			color: Color{Mode: BasicColor, Code: 7},
+			args:  args{"text"},
+			want:  "\x1b[7mtext\x1b[27m",
+		},
+		{
+			color: Color{Mode: IndexedColor, Code: 235},
+			args:  args{"text"},
+			want:  "\x1b[48;5;235mtext\x1b[49m",
+		},
+		{
+			color: Color{Mode: RGBColor, Red: 1, Green: 2, Blue: 3},
+			args:  args{"text"},
+			want:  "\x1b[48;2;1;2;3mtext\x1b[49m",
+		},
+	} {
+		got := data.color.Background(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestInverse(test *testing.T) {
+	got := Inverse("text")
+
+	if got != "\x1b[7mtext\x1b[27m" {
+		test.Fail()
+	}
+}
+
+func TestUnderline(test *testing.T) {
+	got := Underline("text")
+
+	if got != "\x1b[4mtext\x1b[24m" {
+		test.Fail()
+	}
+}
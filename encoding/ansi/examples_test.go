@@ -0,0 +1,23 @@
+package ansi_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ansi"
+)
+
+func ExampleDecodeColor() {
+	color, _ := ansi.DecodeColor("256:208")
+	fmt.Printf("%+v\n", color)
+
+	// Output: {Mode:2 Code:208 Red:0 Green:0 Blue:0}
+}
+
+func ExampleColor_Foreground() {
+	color := ansi.Color{Mode: ansi.RGBColor, Red: 220, Green: 179, Blue: 92}
+	text := color.Foreground("text")
+	fmt.Printf("%v\n", strconv.Quote(text))
+
+	// Output: "\x1b[38;2;220;179;92mtext\x1b[39m"
+}
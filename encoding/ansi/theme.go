@@ -0,0 +1,67 @@
+package ansi
+
+import (
+	"errors"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// Theme ...
+type Theme struct {
+	BlackStone Color
+	WhiteStone Color
+	// it's used for highlighting of stones (e.g. in atari)
+	Highlight Color
+	Grid      Color
+	Legend    Color
+	// it's used as a background color of the board
+	Board Color
+}
+
+// DecodeTheme ...
+//
+// It returns one of the built-in themes by its name: "classic", "wood"
+// (24-bit colors) or "night" (256 colors).
+//
+func DecodeTheme(name string) (Theme, error) {
+	var theme Theme
+	switch name {
+	case "classic":
+		theme = Theme{
+			BlackStone: Color{Mode: BasicColor, Code: 34}, // blue
+			WhiteStone: Color{Mode: BasicColor, Code: 31}, // red
+			Highlight:  Color{Mode: BasicColor, Code: 33}, // yellow
+		}
+	case "wood":
+		theme = Theme{
+			BlackStone: Color{Mode: RGBColor, Red: 0, Green: 0, Blue: 0},
+			WhiteStone: Color{Mode: RGBColor, Red: 255, Green: 255, Blue: 255},
+			Highlight:  Color{Mode: RGBColor, Red: 200, Green: 0, Blue: 0},
+			Grid:       Color{Mode: RGBColor, Red: 92, Green: 64, Blue: 51},
+			Legend:     Color{Mode: RGBColor, Red: 59, Green: 42, Blue: 26},
+			Board:      Color{Mode: RGBColor, Red: 220, Green: 179, Blue: 92},
+		}
+	case "night":
+		theme = Theme{
+			BlackStone: Color{Mode: IndexedColor, Code: 39},
+			WhiteStone: Color{Mode: IndexedColor, Code: 231},
+			Highlight:  Color{Mode: IndexedColor, Code: 208},
+			Grid:       Color{Mode: IndexedColor, Code: 240},
+			Legend:     Color{Mode: IndexedColor, Code: 245},
+			Board:      Color{Mode: IndexedColor, Code: 235},
+		}
+	default:
+		return Theme{}, errors.New("unknown theme")
+	}
+
+	return theme, nil
+}
+
+// StoneColor ...
+func (theme Theme) StoneColor(color models.Color) Color {
+	if color == models.Black {
+		return theme.BlackStone
+	}
+
+	return theme.WhiteStone
+}
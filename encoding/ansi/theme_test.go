@@ -0,0 +1,82 @@
+package ansi
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestDecodeTheme(test *testing.T) {
+	type args struct {
+		name string
+	}
+	type data struct {
+		args      args
+		wantBlack Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{"classic"},
+			wantBlack: Color{Mode: BasicColor, Code: 34},
+			wantErr:   false,
+		},
+		{
+			args:      args{"wood"},
+			wantBlack: Color{Mode: RGBColor},
+			wantErr:   false,
+		},
+		{
+			args:      args{"night"},
+			wantBlack: Color{Mode: IndexedColor, Code: 39},
+			wantErr:   false,
+		},
+		{
+			args:      args{"unknown"},
+			wantBlack: Color{},
+			wantErr:   true,
+		},
+	} {
+		got, gotErr := DecodeTheme(data.args.name)
+
+		if !reflect.DeepEqual(got.BlackStone, data.wantBlack) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestThemeStoneColor(test *testing.T) {
+	type args struct {
+		color models.Color
+	}
+	type data struct {
+		args args
+		want Color
+	}
+
+	theme := Theme{
+		BlackStone: Color{Mode: BasicColor, Code: 34},
+		WhiteStone: Color{Mode: BasicColor, Code: 31},
+	}
+	for _, data := range []data{
+		{
+			args: args{models.Black},
+			want: Color{Mode: BasicColor, Code: 34},
+		},
+		{
+			args: args{models.White},
+			want: Color{Mode: BasicColor, Code: 31},
+		},
+	} {
+		got := theme.StoneColor(data.args.color)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
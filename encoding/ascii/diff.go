@@ -0,0 +1,65 @@
+package ascii
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// DiffEncoders ...
+//
+// They encode stones that differ between two positions. Unchanged stones
+// are encoded in the usual way.
+//
+type DiffEncoders struct {
+	// it's used for stones that are absent in the previous position
+	// or have another color there
+	Added StoneEncoder
+	// it's used for stones that are absent in the current position;
+	// it receives a color of the stone in the previous position
+	Removed StoneEncoder
+}
+
+// NewDiffPointEncoder ...
+//
+// It encodes differences of the encoded storage from the previous one.
+// The storages should have the same size.
+//
+func NewDiffPointEncoder(
+	previous models.StoneStorage,
+	encoders DiffEncoders,
+) PointEncoder {
+	return func(
+		storage models.StoneStorage,
+		point models.Point,
+	) (text string, ok bool) {
+		previousColor, wasStone := previous.Stone(point)
+		color, isStone := storage.Stone(point)
+		switch {
+		case isStone && (!wasStone || color != previousColor):
+			return encoders.Added(color), true
+		case !isStone && wasStone:
+			return encoders.Removed(previousColor), true
+		default:
+			return "", false
+		}
+	}
+}
+
+// EncodeStoneStorageDiff ...
+//
+// It encodes the current storage as EncodeStoneStorage() does, but with
+// distinguishing of stones added and removed since the previous storage.
+// Point encoders take precedence over the diff encoders.
+//
+func (encoder StoneStorageEncoder) EncodeStoneStorageDiff(
+	previous models.StoneStorage,
+	current models.StoneStorage,
+	encoders DiffEncoders,
+	pointEncoders ...PointEncoder,
+) string {
+	pointEncoders = append(
+		pointEncoders[:len(pointEncoders):len(pointEncoders)],
+		NewDiffPointEncoder(previous, encoders),
+	)
+
+	return encoder.EncodeStoneStorage(current, pointEncoders...)
+}
@@ -0,0 +1,133 @@
+package ascii
+
+import (
+	"strings"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+func TestNewDiffPointEncoder(test *testing.T) {
+	type args struct {
+		point models.Point
+	}
+	type data struct {
+		args     args
+		wantText string
+		wantOk   bool
+	}
+
+	previous := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+	} {
+		previous = previous.ApplyMove(move)
+	}
+
+	current := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 2, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+	} {
+		current = current.ApplyMove(move)
+	}
+
+	pointEncoder := NewDiffPointEncoder(previous, DiffEncoders{
+		Added: func(color models.Color) string {
+			return "+" + string(sgf.EncodeColor(color))
+		},
+		Removed: func(color models.Color) string {
+			return "-" + string(sgf.EncodeColor(color))
+		},
+	})
+	for _, data := range []data{
+		{
+			args:     args{models.Point{Column: 0, Row: 0}},
+			wantText: "",
+			wantOk:   false,
+		},
+		{
+			args:     args{models.Point{Column: 1, Row: 0}},
+			wantText: "-W",
+			wantOk:   true,
+		},
+		{
+			args:     args{models.Point{Column: 2, Row: 0}},
+			wantText: "+W",
+			wantOk:   true,
+		},
+		{
+			args:     args{models.Point{Column: 1, Row: 1}},
+			wantText: "+W",
+			wantOk:   true,
+		},
+		{
+			args:     args{models.Point{Column: 2, Row: 2}},
+			wantText: "",
+			wantOk:   false,
+		},
+	} {
+		gotText, gotOk := pointEncoder(current, data.args.point)
+
+		if gotText != data.wantText {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestStoneStorageEncoderEncodeStoneStorageDiff(test *testing.T) {
+	previous := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+	} {
+		previous = previous.ApplyMove(move)
+	}
+
+	current := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 2}},
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 2}},
+	} {
+		current = current.ApplyMove(move)
+	}
+
+	encoder := StoneStorageEncoder{
+		encoder: func(color models.Color) string {
+			return string(sgf.EncodeColor(color))
+		},
+		placeholders: Placeholders{
+			Crosshairs: "+",
+		},
+		stoneWidth: 1,
+	}
+	got := encoder.EncodeStoneStorageDiff(
+		previous,
+		current,
+		DiffEncoders{
+			Added: func(color models.Color) string {
+				return strings.ToLower(string(sgf.EncodeColor(color)))
+			},
+			Removed: func(color models.Color) string {
+				return "x"
+			},
+		},
+		NewMarkedPointEncoder([]models.Point{{Column: 0, Row: 2}}, "*"),
+	)
+
+	want := "c*+b\n" +
+		"b+W+\n" +
+		"ax++\n" +
+		" abc"
+	if got != want {
+		test.Fail()
+	}
+}
@@ -2,6 +2,7 @@ package ascii_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
 	models "github.com/thewizardplusplus/go-atari-models"
@@ -191,3 +192,64 @@ func ExampleStoneStorageEncoder_EncodeStoneStorage_withGrid() {
 	//    | | | | |
 	//    a b c d e
 }
+
+func ExampleStoneStorageEncoder_EncodeStoneStorageDiff() {
+	stoneEncoder := func(color models.Color) string {
+		return string(sgf.EncodeColor(color))
+	}
+	placeholders := ascii.Placeholders{
+		Crosshairs: "+",
+	}
+
+	previous := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+	} {
+		previous = previous.ApplyMove(move)
+	}
+
+	current := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 2}},
+	} {
+		current = current.ApplyMove(move)
+	}
+
+	boardEncoder :=
+		ascii.NewStoneStorageEncoder(stoneEncoder, placeholders, ascii.Margins{}, 1)
+	fmt.Printf("%v\n", boardEncoder.EncodeStoneStorageDiff(
+		previous,
+		current,
+		ascii.DiffEncoders{
+			Added: func(color models.Color) string {
+				return strings.ToLower(string(sgf.EncodeColor(color)))
+			},
+			Removed: func(color models.Color) string {
+				return "x"
+			},
+		},
+	))
+
+	// Output:
+	// c++b
+	// b+W+
+	// ax++
+	//  abc
+}
+
+func ExampleJoinHorizontally() {
+	text := ascii.JoinHorizontally(
+		"   ",
+		ascii.Block{Title: "before", Text: "b+++\na+B+\n abc"},
+		ascii.Block{Title: "after", Text: "b+W+\na+B+\n abc"},
+	)
+	fmt.Printf("%v\n", text)
+
+	// Output:
+	// before   after
+	// b+++     b+W+
+	// a+B+     a+B+
+	//  abc      abc
+}
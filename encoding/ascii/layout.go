@@ -0,0 +1,78 @@
+package ascii
+
+import (
+	"strings"
+)
+
+// Block ...
+//
+// It's a multiline text (e.g. an encoded board) with an optional title
+// for composing by JoinHorizontally().
+//
+type Block struct {
+	Title string
+	Text  string
+}
+
+// JoinHorizontally ...
+//
+// It places the blocks next to each other separated by the gap. Lines
+// of the blocks are aligned by their display widths (see TextWidth()),
+// so they may contain ANSI escape sequences. If any block has a title,
+// the titles are placed above the texts of all the blocks.
+//
+func JoinHorizontally(gap string, blocks ...Block) string {
+	var hasTitles bool
+	for _, block := range blocks {
+		if block.Title != "" {
+			hasTitles = true
+			break
+		}
+	}
+
+	var columns [][]string
+	var height int
+	for _, block := range blocks {
+		var lines []string
+		if hasTitles {
+			lines = append(lines, block.Title)
+		}
+		lines = append(lines, strings.Split(block.Text, "\n")...)
+
+		columns = append(columns, lines)
+		height = max(height, len(lines))
+	}
+
+	var widths []int
+	for _, lines := range columns {
+		var width int
+		for _, line := range lines {
+			width = max(width, TextWidth(line))
+		}
+
+		widths = append(widths, width)
+	}
+
+	var lines []string
+	for index := 0; index < height; index++ {
+		var line string
+		for columnIndex, column := range columns {
+			var columnLine string
+			if index < len(column) {
+				columnLine = column[index]
+			}
+
+			// don't pad the last column to avoid trailing spaces
+			if columnIndex == len(columns)-1 {
+				line += columnLine
+				continue
+			}
+
+			line += padToWidth(columnLine, widths[columnIndex], " ") + gap
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,64 @@
+package ascii
+
+import (
+	"testing"
+)
+
+func TestJoinHorizontally(test *testing.T) {
+	type args struct {
+		gap    string
+		blocks []Block
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				gap:    " | ",
+				blocks: nil,
+			},
+			want: "",
+		},
+		{
+			args: args{
+				gap:    " | ",
+				blocks: []Block{{Text: "ab\ncd"}},
+			},
+			want: "ab\ncd",
+		},
+		{
+			args: args{
+				gap: " | ",
+				blocks: []Block{
+					{Text: "a\nbcd"},
+					{Text: "ef\ng\nh"},
+				},
+			},
+			want: "a   | ef\n" +
+				"bcd | g\n" +
+				"    | h",
+		},
+		{
+			args: args{
+				gap: "  ",
+				blocks: []Block{
+					{Title: "before", Text: "ab\ncd"},
+					{Text: "\x1b[31mef\x1b[39m\ngh"},
+					{Title: "after", Text: "\u26ab\u26aa\nij"},
+				},
+			},
+			want: "before      after\n" +
+				"ab      \x1b[31mef\x1b[39m  \u26ab\u26aa\n" +
+				"cd      gh  ij",
+		},
+	} {
+		got := JoinHorizontally(data.args.gap, data.args.blocks...)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package ascii
 
+import (
+	"fmt"
+	"strings"
+)
+
 // HorizontalMargins ...
 type HorizontalMargins struct {
 	Left  int
@@ -23,10 +28,64 @@ type StoneMargins struct {
 	VerticalMargins
 }
 
+// LegendPlacement ...
+//
+// It's a set of board sides, where the legend is displayed. The zero value
+// means the left and the bottom sides.
+//
+type LegendPlacement int
+
+// ...
+const (
+	LeftLegend LegendPlacement = 1 << iota
+	RightLegend
+	TopLegend
+	BottomLegend
+)
+
+// DecodeLegendPlacement ...
+//
+// It decodes a comma-separated list of board sides (left, right, top
+// and bottom), e.g. "left,top".
+//
+func DecodeLegendPlacement(text string) (LegendPlacement, error) {
+	var placement LegendPlacement
+	for _, side := range strings.Split(text, ",") {
+		switch strings.TrimSpace(side) {
+		case "left":
+			placement |= LeftLegend
+		case "right":
+			placement |= RightLegend
+		case "top":
+			placement |= TopLegend
+		case "bottom":
+			placement |= BottomLegend
+		default:
+			return 0, fmt.Errorf("incorrect legend side %q", side)
+		}
+	}
+
+	return placement, nil
+}
+
+// Has ...
+func (placement LegendPlacement) Has(side LegendPlacement) bool {
+	if placement == 0 {
+		placement = LeftLegend | BottomLegend
+	}
+
+	return placement&side != 0
+}
+
 // LegendMargins ...
+//
+// For the right and the top legends, the margins are mirrored, so Row.Right
+// and Column.Top are always the ones between the legend and the board.
+//
 type LegendMargins struct {
-	Column VerticalMargins
-	Row    HorizontalMargins
+	Column    VerticalMargins
+	Row       HorizontalMargins
+	Placement LegendPlacement
 }
 
 // Margins ...
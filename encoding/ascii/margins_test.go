@@ -41,3 +41,96 @@ func TestHorizontalMarginsWidth(test *testing.T) {
 		}
 	}
 }
+
+func TestLegendPlacementHas(test *testing.T) {
+	type args struct {
+		side LegendPlacement
+	}
+	type data struct {
+		placement LegendPlacement
+		args      args
+		want      bool
+	}
+
+	for _, data := range []data{
+		{
+			placement: 0,
+			args:      args{LeftLegend},
+			want:      true,
+		},
+		{
+			placement: 0,
+			args:      args{BottomLegend},
+			want:      true,
+		},
+		{
+			placement: 0,
+			args:      args{TopLegend},
+			want:      false,
+		},
+		{
+			placement: RightLegend | TopLegend,
+			args:      args{TopLegend},
+			want:      true,
+		},
+		{
+			placement: RightLegend | TopLegend,
+			args:      args{LeftLegend},
+			want:      false,
+		},
+	} {
+		got := data.placement.Has(data.args.side)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeLegendPlacement(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    LegendPlacement
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"left"},
+			want:    LeftLegend,
+			wantErr: false,
+		},
+		{
+			args:    args{"left, right,top,bottom"},
+			want:    LeftLegend | RightLegend | TopLegend | BottomLegend,
+			wantErr: false,
+		},
+		{
+			args:    args{"top,top"},
+			want:    TopLegend,
+			wantErr: false,
+		},
+		{
+			args:    args{""},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			args:    args{"left,middle"},
+			want:    0,
+			wantErr: true,
+		},
+	} {
+		got, gotErr := DecodeLegendPlacement(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package ascii
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// PointEncoder ...
+//
+// It should return false as the second result, if it doesn't encode
+// the point; then the point will be encoded in the usual way.
+//
+type PointEncoder func(
+	storage models.StoneStorage,
+	point models.Point,
+) (text string, ok bool)
+
+// NewMarkedStoneEncoder ...
+//
+// It encodes only stones at the specified points.
+//
+func NewMarkedStoneEncoder(
+	points []models.Point,
+	encoder StoneEncoder,
+) PointEncoder {
+	markedPoints := newPointSet(points)
+	return func(
+		storage models.StoneStorage,
+		point models.Point,
+	) (text string, ok bool) {
+		if !markedPoints[point] {
+			return "", false
+		}
+
+		color, ok := storage.Stone(point)
+		if !ok {
+			return "", false
+		}
+
+		return encoder(color), true
+	}
+}
+
+// NewMarkedPointEncoder ...
+//
+// It encodes the specified points by the specified text.
+//
+func NewMarkedPointEncoder(points []models.Point, text string) PointEncoder {
+	markedPoints := newPointSet(points)
+	return func(
+		storage models.StoneStorage,
+		point models.Point,
+	) (markedText string, ok bool) {
+		if !markedPoints[point] {
+			return "", false
+		}
+
+		return text, true
+	}
+}
+
+func newPointSet(points []models.Point) map[models.Point]bool {
+	pointSet := make(map[models.Point]bool)
+	for _, point := range points {
+		pointSet[point] = true
+	}
+
+	return pointSet
+}
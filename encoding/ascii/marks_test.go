@@ -0,0 +1,108 @@
+package ascii
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+func TestNewMarkedStoneEncoder(test *testing.T) {
+	type args struct {
+		point models.Point
+	}
+	type data struct {
+		args     args
+		wantText string
+		wantOk   bool
+	}
+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+	} {
+		board = board.ApplyMove(move)
+	}
+
+	pointEncoder := NewMarkedStoneEncoder(
+		[]models.Point{{Column: 0, Row: 0}, {Column: 2, Row: 2}},
+		func(color models.Color) string {
+			return "*" + string(sgf.EncodeColor(color))
+		},
+	)
+	for _, data := range []data{
+		{
+			args:     args{models.Point{Column: 0, Row: 0}},
+			wantText: "*B",
+			wantOk:   true,
+		},
+		{
+			args:     args{models.Point{Column: 1, Row: 0}},
+			wantText: "",
+			wantOk:   false,
+		},
+		{
+			args:     args{models.Point{Column: 2, Row: 2}},
+			wantText: "",
+			wantOk:   false,
+		},
+	} {
+		gotText, gotOk := pointEncoder(board, data.args.point)
+
+		if gotText != data.wantText {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewMarkedPointEncoder(test *testing.T) {
+	type args struct {
+		point models.Point
+	}
+	type data struct {
+		args     args
+		wantText string
+		wantOk   bool
+	}
+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	board = board.ApplyMove(models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	})
+
+	pointEncoder := NewMarkedPointEncoder(
+		[]models.Point{{Column: 0, Row: 0}, {Column: 2, Row: 2}},
+		"x",
+	)
+	for _, data := range []data{
+		{
+			args:     args{models.Point{Column: 0, Row: 0}},
+			wantText: "x",
+			wantOk:   true,
+		},
+		{
+			args:     args{models.Point{Column: 1, Row: 0}},
+			wantText: "",
+			wantOk:   false,
+		},
+		{
+			args:     args{models.Point{Column: 2, Row: 2}},
+			wantText: "x",
+			wantOk:   true,
+		},
+	} {
+		gotText, gotOk := pointEncoder(board, data.args.point)
+
+		if gotText != data.wantText {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
@@ -10,19 +10,92 @@ import (
 // StoneEncoder ...
 type StoneEncoder func(color models.Color) string
 
+// TextEncoder ...
+type TextEncoder func(text string) string
+
+// AxisEncoder ...
+//
+// It encodes axes for the board legend.
+//
+type AxisEncoder interface {
+	EncodeColumn(column int) string
+	EncodeRow(row int) string
+}
+
 // Placeholders ...
 type Placeholders struct {
 	HorizontalLine string
 	VerticalLine   string
 	Crosshairs     string
+	// it's used for empty star points; if it's empty, Crosshairs is used
+	StarPoint string
 }
 
 // StoneStorageEncoder ...
 type StoneStorageEncoder struct {
-	encoder      StoneEncoder
-	placeholders Placeholders
-	margins      Margins
-	stoneWidth   int
+	encoder       StoneEncoder
+	placeholders  Placeholders
+	margins       Margins
+	stoneWidth    int
+	legendEncoder TextEncoder
+	lineEncoder   TextEncoder
+	axisEncoder   AxisEncoder
+	starPoints    map[models.Point]bool
+}
+
+// it describes widths of board parts, which depend on the board size
+// because of multi-character axis labels
+type layout struct {
+	cellWidth        int
+	rowLabelWidth    int
+	leftLegendWidth  int
+	rightLegendWidth int
+}
+
+// StoneStorageEncoderOption ...
+type StoneStorageEncoderOption func(encoder *StoneStorageEncoder)
+
+// WithLegendEncoder ...
+//
+// It sets an encoder for each axis label of the legend
+// (e.g. for its coloring).
+//
+func WithLegendEncoder(legendEncoder TextEncoder) StoneStorageEncoderOption {
+	return func(encoder *StoneStorageEncoder) {
+		encoder.legendEncoder = legendEncoder
+	}
+}
+
+// WithLineEncoder ...
+//
+// It sets an encoder for each resulting line of the board
+// (e.g. for setting its background).
+//
+func WithLineEncoder(lineEncoder TextEncoder) StoneStorageEncoderOption {
+	return func(encoder *StoneStorageEncoder) {
+		encoder.lineEncoder = lineEncoder
+	}
+}
+
+// WithAxisEncoder ...
+//
+// It sets an encoder of axes for the legend; by default, the axes
+// are encoded as in Smart Game Format.
+//
+func WithAxisEncoder(axisEncoder AxisEncoder) StoneStorageEncoderOption {
+	return func(encoder *StoneStorageEncoder) {
+		encoder.axisEncoder = axisEncoder
+	}
+}
+
+// WithStarPoints ...
+//
+// It sets star points (hoshi) displayed by Placeholders.StarPoint.
+//
+func WithStarPoints(starPoints []models.Point) StoneStorageEncoderOption {
+	return func(encoder *StoneStorageEncoder) {
+		encoder.starPoints = newPointSet(starPoints)
+	}
 }
 
 // NewStoneStorageEncoder ...
@@ -31,44 +104,65 @@ func NewStoneStorageEncoder(
 	placeholders Placeholders,
 	margins Margins,
 	stoneWidth int,
+	options ...StoneStorageEncoderOption,
 ) StoneStorageEncoder {
-	return StoneStorageEncoder{
+	storageEncoder := StoneStorageEncoder{
 		encoder:      encoder,
 		placeholders: placeholders,
 		margins:      margins,
 		stoneWidth:   stoneWidth,
 	}
+	for _, option := range options {
+		option(&storageEncoder)
+	}
+
+	return storageEncoder
 }
 
 // EncodeStoneStorage ...
+//
+// Point encoders are tried in the specified order before the usual encoding
+// of each point. Multi-character axis labels are supported: row labels
+// are padded to the same width, and stone columns are widened up to the widest
+// column label.
+//
 func (encoder StoneStorageEncoder) EncodeStoneStorage(
 	storage models.StoneStorage,
+	pointEncoders ...PointEncoder,
 ) string {
 	stoneMargins, legendMargins := encoder.margins.Stone, encoder.margins.Legend
+	placement := legendMargins.Placement
+	layout := encoder.layout(storage.Size())
 
 	var rows []string
 	var currentRow string
 	for _, point := range storage.Size().Points() {
-		if len(currentRow) == 0 {
+		if point.Column == 0 && placement.Has(LeftLegend) {
 			currentRow += encoder.wrapWithSpaces(
-				string(sgf.EncodeAxis(point.Row)),
+				encoder.encodeRowLegend(point.Row, layout.rowLabelWidth, true),
 				legendMargins.Row,
 			)
 		}
 
-		var encodedStone string
-		if color, ok := storage.Stone(point); ok {
-			encodedStone = encoder.encoder(color)
-		} else {
-			encodedStone = encoder.placeholders.Crosshairs
-		}
+		encodedStone := encoder.EncodePoint(storage, point, pointEncoders...)
 		currentRow += encoder.wrapWithSpaces(
-			encodedStone,
+			padToWidth(
+				encodedStone,
+				layout.cellWidth,
+				encoder.placeholders.HorizontalLine,
+			),
 			stoneMargins.HorizontalMargins,
 			encoder.placeholders.HorizontalLine,
 		)
 
-		if lastColumn := storage.Size().Height - 1; point.Column == lastColumn {
+		if lastColumn := storage.Size().Width - 1; point.Column == lastColumn {
+			if placement.Has(RightLegend) {
+				currentRow += encoder.wrapWithSpaces(
+					encoder.encodeRowLegend(point.Row, layout.rowLabelWidth, false),
+					mirrorHorizontalMargins(legendMargins.Row),
+				)
+			}
+
 			rows = append(rows, currentRow)
 			currentRow = ""
 		}
@@ -80,33 +174,215 @@ func (encoder StoneStorageEncoder) EncodeStoneStorage(
 		sparseRows = append(sparseRows, encoder.wrapWithEmptyLines(
 			[]string{row},
 			storage.Size().Width,
+			layout,
 			stoneMargins.VerticalMargins,
 			encoder.placeholders.VerticalLine,
 		)...)
 	}
 
-	legendRow := encoder.spaces(legendMargins.Row.Width(1))
+	legendRow := encoder.spaces(layout.leftLegendWidth)
 	for i := 0; i < storage.Size().Width; i++ {
 		legendRow += encoder.wrapWithSpaces(
-			string(sgf.EncodeAxis(i)),
+			encoder.encodeColumnLegend(i, layout.cellWidth),
 			stoneMargins.HorizontalMargins,
 		)
 	}
-	sparseRows = append(sparseRows, encoder.wrapWithEmptyLines(
-		[]string{legendRow},
-		storage.Size().Width,
-		legendMargins.Column,
-	)...)
+	legendRow += encoder.spaces(layout.rightLegendWidth)
+	if placement.Has(TopLegend) {
+		sparseRows = append(encoder.wrapWithEmptyLines(
+			[]string{legendRow},
+			storage.Size().Width,
+			layout,
+			mirrorVerticalMargins(legendMargins.Column),
+		), sparseRows...)
+	}
+	if placement.Has(BottomLegend) {
+		sparseRows = append(sparseRows, encoder.wrapWithEmptyLines(
+			[]string{legendRow},
+			storage.Size().Width,
+			layout,
+			legendMargins.Column,
+		)...)
+	}
 
 	sparseRows = encoder.wrapWithEmptyLines(
 		sparseRows,
 		storage.Size().Width,
+		layout,
 		encoder.margins.Board,
 	)
 
+	if encoder.lineEncoder != nil {
+		for index, row := range sparseRows {
+			sparseRows[index] = encoder.lineEncoder(row)
+		}
+	}
+
 	return strings.Join(sparseRows, "\n")
 }
 
+// EncodePoint ...
+//
+// It encodes a single point in the same way as EncodeStoneStorage() does,
+// but without margins.
+//
+func (encoder StoneStorageEncoder) EncodePoint(
+	storage models.StoneStorage,
+	point models.Point,
+	pointEncoders ...PointEncoder,
+) string {
+	for _, pointEncoder := range pointEncoders {
+		if text, ok := pointEncoder(storage, point); ok {
+			return text
+		}
+	}
+
+	if color, ok := storage.Stone(point); ok {
+		return encoder.encoder(color)
+	}
+
+	if encoder.starPoints[point] && encoder.placeholders.StarPoint != "" {
+		return encoder.placeholders.StarPoint
+	}
+
+	return encoder.placeholders.Crosshairs
+}
+
+// LocatePoint ...
+//
+// It finds a board point by a position in the text encoded
+// by EncodeStoneStorage(): x is a zero-based display column, and y is
+// a zero-based line. Margins of stones are treated as parts of them.
+//
+func (encoder StoneStorageEncoder) LocatePoint(
+	size models.Size,
+	x int,
+	y int,
+) (point models.Point, ok bool) {
+	stoneMargins, legendMargins := encoder.margins.Stone, encoder.margins.Legend
+
+	layout := encoder.layout(size)
+	x -= layout.leftLegendWidth
+	y -= encoder.margins.Board.Top
+	if legendMargins.Placement.Has(TopLegend) {
+		y -= legendMargins.Column.Top + 1 + legendMargins.Column.Bottom
+	}
+	if x < 0 || y < 0 {
+		return models.Point{}, false
+	}
+
+	stoneWidth := stoneMargins.HorizontalMargins.Width(layout.cellWidth)
+	stoneHeight := stoneMargins.Top + 1 + stoneMargins.Bottom
+	column, reversedRow := x/stoneWidth, y/stoneHeight
+	if column >= size.Width || reversedRow >= size.Height {
+		return models.Point{}, false
+	}
+
+	point = models.Point{
+		Column: column,
+		Row:    size.Height - reversedRow - 1,
+	}
+	return point, true
+}
+
+func (encoder StoneStorageEncoder) layout(size models.Size) layout {
+	var layout layout
+	layout.cellWidth = encoder.cellWidth()
+	if placement := encoder.margins.Legend.Placement; placement.Has(TopLegend) ||
+		placement.Has(BottomLegend) {
+		for column := 0; column < size.Width; column++ {
+			labelWidth := TextWidth(encoder.encodeColumnAxis(column))
+			layout.cellWidth = max(layout.cellWidth, labelWidth)
+		}
+	}
+
+	layout.rowLabelWidth = 1
+	for row := 0; row < size.Height; row++ {
+		labelWidth := TextWidth(encoder.encodeRowAxis(row))
+		layout.rowLabelWidth = max(layout.rowLabelWidth, labelWidth)
+	}
+
+	legendMargins := encoder.margins.Legend
+	if legendMargins.Placement.Has(LeftLegend) {
+		layout.leftLegendWidth = legendMargins.Row.Width(layout.rowLabelWidth)
+	}
+	if legendMargins.Placement.Has(RightLegend) {
+		layout.rightLegendWidth = legendMargins.Row.Width(layout.rowLabelWidth)
+	}
+
+	return layout
+}
+
+// it returns the maximal display width of encoded stones and placeholders,
+// but not less than the stone width specified on the encoder creation
+func (encoder StoneStorageEncoder) cellWidth() int {
+	texts := []string{
+		encoder.placeholders.Crosshairs,
+		encoder.placeholders.StarPoint,
+	}
+	if encoder.encoder != nil {
+		texts = append(texts, encoder.encoder(models.Black))
+		texts = append(texts, encoder.encoder(models.White))
+	}
+
+	width := encoder.stoneWidth
+	for _, text := range texts {
+		width = max(width, TextWidth(text))
+	}
+
+	return width
+}
+
+func (encoder StoneStorageEncoder) encodeColumnAxis(column int) string {
+	if encoder.axisEncoder != nil {
+		return encoder.axisEncoder.EncodeColumn(column)
+	}
+
+	return string(sgf.EncodeAxis(column))
+}
+
+func (encoder StoneStorageEncoder) encodeRowAxis(row int) string {
+	if encoder.axisEncoder != nil {
+		return encoder.axisEncoder.EncodeRow(row)
+	}
+
+	return string(sgf.EncodeAxis(row))
+}
+
+// column labels are aligned to the left, i.e. to the stones
+func (encoder StoneStorageEncoder) encodeColumnLegend(
+	column int,
+	width int,
+) string {
+	text := padToWidth(encoder.encodeColumnAxis(column), width, " ")
+	return encoder.encodeLegend(text)
+}
+
+// row labels are aligned to the board (i.e. to the right for the left legend,
+// and to the left for the right one)
+func (encoder StoneStorageEncoder) encodeRowLegend(
+	row int,
+	width int,
+	alignToRight bool,
+) string {
+	text := encoder.encodeRowAxis(row)
+	if alignToRight {
+		text = encoder.spaces(width-TextWidth(text)) + text
+	} else {
+		text = padToWidth(text, width, " ")
+	}
+
+	return encoder.encodeLegend(text)
+}
+
+func (encoder StoneStorageEncoder) encodeLegend(text string) string {
+	if encoder.legendEncoder == nil {
+		return text
+	}
+
+	return encoder.legendEncoder(text)
+}
+
 func (encoder StoneStorageEncoder) wrapWithSpaces(
 	text string,
 	margins HorizontalMargins,
@@ -133,6 +409,7 @@ func (encoder StoneStorageEncoder) spaces(
 func (encoder StoneStorageEncoder) wrapWithEmptyLines(
 	lines []string,
 	width int,
+	layout layout,
 	margins VerticalMargins,
 	optionalSeparator ...string,
 ) []string {
@@ -140,12 +417,14 @@ func (encoder StoneStorageEncoder) wrapWithEmptyLines(
 	wrappedLines = append(wrappedLines, encoder.emptyLines(
 		margins.Top,
 		width,
+		layout,
 		optionalSeparator...,
 	)...)
 	wrappedLines = append(wrappedLines, lines...)
 	wrappedLines = append(wrappedLines, encoder.emptyLines(
 		margins.Bottom,
 		width,
+		layout,
 		optionalSeparator...,
 	)...)
 
@@ -155,11 +434,12 @@ func (encoder StoneStorageEncoder) wrapWithEmptyLines(
 func (encoder StoneStorageEncoder) emptyLines(
 	count int,
 	width int,
+	layout layout,
 	optionalSeparator ...string,
 ) []string {
 	var lines []string
 	for i := 0; i < count; i++ {
-		line := encoder.emptyLine(width, optionalSeparator...)
+		line := encoder.emptyLine(width, layout, optionalSeparator...)
 		lines = append(lines, line)
 	}
 
@@ -168,9 +448,10 @@ func (encoder StoneStorageEncoder) emptyLines(
 
 func (encoder StoneStorageEncoder) emptyLine(
 	width int,
+	layout layout,
 	optionalSeparator ...string,
 ) string {
-	stoneMargins, legendMargins := encoder.margins.Stone, encoder.margins.Legend
+	stoneMargins := encoder.margins.Stone
 
 	var separator string
 	if len(optionalSeparator) != 0 {
@@ -179,16 +460,55 @@ func (encoder StoneStorageEncoder) emptyLine(
 		separator = " "
 	}
 
-	line := encoder.spaces(legendMargins.Row.Width(1))
+	separator = padToWidth(separator, layout.cellWidth, " ")
+	line := encoder.spaces(layout.leftLegendWidth)
 	for i := 0; i < width; i++ {
 		line += encoder.spaces(stoneMargins.Left) +
 			separator +
 			encoder.spaces(stoneMargins.Right)
 	}
+	line += encoder.spaces(layout.rightLegendWidth)
 
 	return line
 }
 
+// it pads the text on the right by the symbol (or by spaces, if the symbol
+// is empty) up to the display width
+func padToWidth(text string, width int, symbol string) string {
+	if symbol == "" {
+		symbol = " "
+	}
+
+	padding := width - TextWidth(text)
+	if padding <= 0 {
+		return text
+	}
+
+	return text + strings.Repeat(symbol, padding)
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func mirrorHorizontalMargins(margins HorizontalMargins) HorizontalMargins {
+	return HorizontalMargins{
+		Left:  margins.Right,
+		Right: margins.Left,
+	}
+}
+
+func mirrorVerticalMargins(margins VerticalMargins) VerticalMargins {
+	return VerticalMargins{
+		Top:    margins.Bottom,
+		Bottom: margins.Top,
+	}
+}
+
 func reverse(strings []string) {
 	left, right := 0, len(strings)-1
 	for left < right {
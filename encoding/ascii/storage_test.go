@@ -2,6 +2,7 @@ package ascii
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -9,6 +10,26 @@ import (
 	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
 )
 
+type upperAxisEncoder struct{}
+
+func (upperAxisEncoder) EncodeColumn(column int) string {
+	return string(rune('A' + column))
+}
+
+func (upperAxisEncoder) EncodeRow(row int) string {
+	return strconv.Itoa(row + 1)
+}
+
+type shiftedAxisEncoder struct{}
+
+func (shiftedAxisEncoder) EncodeColumn(column int) string {
+	return strconv.Itoa(column + 9)
+}
+
+func (shiftedAxisEncoder) EncodeRow(row int) string {
+	return strconv.Itoa(row + 9)
+}
+
 func TestNewStoneStorageEncoder(test *testing.T) {
 	stoneEncoder := func(color models.Color) string {
 		return string(sgf.EncodeColor(color))
@@ -40,11 +61,21 @@ func TestNewStoneStorageEncoder(test *testing.T) {
 			},
 		},
 	}
+	legendEncoder := func(text string) string {
+		return "(" + text + ")"
+	}
+	lineEncoder := func(text string) string {
+		return "[" + text + "]"
+	}
 	encoder := NewStoneStorageEncoder(
 		stoneEncoder,
 		placeholders,
 		margins,
 		2,
+		WithLegendEncoder(legendEncoder),
+		WithLineEncoder(lineEncoder),
+		WithAxisEncoder(upperAxisEncoder{}),
+		WithStarPoints([]models.Point{{Column: 1, Row: 1}}),
 	)
 
 	gotEncoder := reflect.ValueOf(encoder.encoder).Pointer()
@@ -64,17 +95,43 @@ func TestNewStoneStorageEncoder(test *testing.T) {
 	if encoder.stoneWidth != 2 {
 		test.Fail()
 	}
+
+	gotLegendEncoder := reflect.ValueOf(encoder.legendEncoder).Pointer()
+	wantLegendEncoder := reflect.ValueOf(legendEncoder).Pointer()
+	if gotLegendEncoder != wantLegendEncoder {
+		test.Fail()
+	}
+
+	gotLineEncoder := reflect.ValueOf(encoder.lineEncoder).Pointer()
+	wantLineEncoder := reflect.ValueOf(lineEncoder).Pointer()
+	if gotLineEncoder != wantLineEncoder {
+		test.Fail()
+	}
+
+	if !reflect.DeepEqual(encoder.axisEncoder, upperAxisEncoder{}) {
+		test.Fail()
+	}
+
+	wantStarPoints := map[models.Point]bool{{Column: 1, Row: 1}: true}
+	if !reflect.DeepEqual(encoder.starPoints, wantStarPoints) {
+		test.Fail()
+	}
 }
 
 func TestStoneStorageEncoderEncodeStoneStorage(test *testing.T) {
 	type fields struct {
-		encoder      StoneEncoder
-		placeholders Placeholders
-		margins      Margins
-		stoneWidth   int
+		encoder       StoneEncoder
+		placeholders  Placeholders
+		margins       Margins
+		stoneWidth    int
+		legendEncoder TextEncoder
+		lineEncoder   TextEncoder
+		axisEncoder   AxisEncoder
+		starPoints    map[models.Point]bool
 	}
 	type args struct {
-		storage models.StoneStorage
+		storage       models.StoneStorage
+		pointEncoders []PointEncoder
 	}
 	type data struct {
 		fields fields
@@ -946,17 +1003,642 @@ func TestStoneStorageEncoderEncodeStoneStorage(test *testing.T) {
 				strings.Repeat(" ", 4) + "\n" +
 				strings.Repeat(" ", 4),
 		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(
+						models.Size{
+							Width:  3,
+							Height: 3,
+						},
+					)
+
+					for _, move := range []models.Move{
+						{
+							Color: models.White,
+							Point: models.Point{
+								Column: 1,
+								Row:    0,
+							},
+						},
+						{
+							Color: models.Black,
+							Point: models.Point{
+								Column: 1,
+								Row:    1,
+							},
+						},
+					} {
+						board = board.ApplyMove(move)
+					}
+
+					return board
+				}(),
+				pointEncoders: []PointEncoder{
+					func(
+						storage models.StoneStorage,
+						point models.Point,
+					) (text string, ok bool) {
+						if point != (models.Point{Column: 0, Row: 0}) {
+							return "", false
+						}
+
+						return "x", true
+					},
+					NewMarkedStoneEncoder(
+						[]models.Point{
+							{
+								Column: 1,
+								Row:    1,
+							},
+						},
+						func(color models.Color) string {
+							return "b"
+						},
+					),
+				},
+			},
+			want: "c+++\n" +
+				"b+b+\n" +
+				"axW+\n" +
+				" abc",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins:    Margins{},
+				stoneWidth: 1,
+				legendEncoder: func(text string) string {
+					return strings.ToUpper(text)
+				},
+				lineEncoder: func(text string) string {
+					return "[" + text + "]"
+				},
+			},
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(
+						models.Size{
+							Width:  3,
+							Height: 3,
+						},
+					)
+
+					for _, move := range []models.Move{
+						{
+							Color: models.White,
+							Point: models.Point{
+								Column: 1,
+								Row:    0,
+							},
+						},
+						{
+							Color: models.Black,
+							Point: models.Point{
+								Column: 1,
+								Row:    1,
+							},
+						},
+					} {
+						board = board.ApplyMove(move)
+					}
+
+					return board
+				}(),
+			},
+			want: "[C+++]\n" +
+				"[B+B+]\n" +
+				"[A+W+]\n" +
+				"[ ABC]",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins:     Margins{},
+				stoneWidth:  1,
+				axisEncoder: upperAxisEncoder{},
+			},
+			args: args{
+				storage: models.NewBoard(
+					models.Size{
+						Width:  3,
+						Height: 3,
+					},
+				),
+			},
+			want: "3+++\n" +
+				"2+++\n" +
+				"1+++\n" +
+				" ABC",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins: Margins{
+					Legend: LegendMargins{
+						Row: HorizontalMargins{
+							Right: 1,
+						},
+						Placement: LeftLegend | RightLegend | TopLegend | BottomLegend,
+					},
+				},
+				stoneWidth: 1,
+			},
+			args: args{
+				storage: models.NewBoard(
+					models.Size{
+						Width:  3,
+						Height: 3,
+					},
+				),
+			},
+			want: "  abc  \n" +
+				"c +++ c\n" +
+				"b +++ b\n" +
+				"a +++ a\n" +
+				"  abc  ",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins: Margins{
+					Stone: StoneMargins{
+						VerticalMargins: VerticalMargins{
+							Bottom: 1,
+						},
+					},
+					Legend: LegendMargins{
+						Column: VerticalMargins{
+							Top: 1,
+						},
+						Placement: RightLegend | TopLegend,
+					},
+				},
+				stoneWidth: 1,
+			},
+			args: args{
+				storage: models.NewBoard(
+					models.Size{
+						Width:  2,
+						Height: 2,
+					},
+				),
+			},
+			want: "ab \n" +
+				"   \n" +
+				"++b\n" +
+				"|| \n" +
+				"++a\n" +
+				"|| ",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+					StarPoint:      "*",
+				},
+				margins:    Margins{},
+				stoneWidth: 1,
+				starPoints: map[models.Point]bool{
+					{Column: 0, Row: 0}: true,
+					{Column: 1, Row: 1}: true,
+				},
+			},
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(
+						models.Size{
+							Width:  3,
+							Height: 3,
+						},
+					)
+					board = board.ApplyMove(models.Move{
+						Color: models.Black,
+						Point: models.Point{
+							Column: 1,
+							Row:    1,
+						},
+					})
+
+					return board
+				}(),
+			},
+			want: "c+++\n" +
+				"b+B+\n" +
+				"a*++\n" +
+				" abc",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					if color == models.Black {
+						return "\u26ab"
+					}
+
+					return "\u26aa"
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins: Margins{
+					Stone: StoneMargins{
+						HorizontalMargins: HorizontalMargins{
+							Left: 1,
+						},
+						VerticalMargins: VerticalMargins{
+							Bottom: 1,
+						},
+					},
+					Legend: LegendMargins{
+						Row: HorizontalMargins{
+							Right: 1,
+						},
+					},
+				},
+				stoneWidth: 1,
+			},
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(
+						models.Size{
+							Width:  2,
+							Height: 2,
+						},
+					)
+					board = board.ApplyMove(models.Move{
+						Color: models.Black,
+						Point: models.Point{
+							Column: 0,
+							Row:    0,
+						},
+					})
+
+					return board
+				}(),
+			},
+			want: "b -+--+-\n" +
+				"   |  | \n" +
+				"a -\u26ab-+-\n" +
+				"   |  | \n" +
+				"   a  b ",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins: Margins{
+					Legend: LegendMargins{
+						Row: HorizontalMargins{
+							Right: 1,
+						},
+					},
+				},
+				stoneWidth:  1,
+				axisEncoder: shiftedAxisEncoder{},
+			},
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(
+						models.Size{
+							Width:  2,
+							Height: 2,
+						},
+					)
+					board = board.ApplyMove(models.Move{
+						Color: models.White,
+						Point: models.Point{
+							Column: 1,
+							Row:    1,
+						},
+					})
+
+					return board
+				}(),
+			},
+			want: "10 +-W-\n" +
+				" 9 +-+-\n" +
+				"   9 10",
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return string(sgf.EncodeColor(color))
+				},
+				placeholders: Placeholders{
+					HorizontalLine: "-",
+					VerticalLine:   "|",
+					Crosshairs:     "+",
+				},
+				margins: Margins{
+					Legend: LegendMargins{
+						Row: HorizontalMargins{
+							Right: 1,
+						},
+						Placement: RightLegend,
+					},
+				},
+				stoneWidth:  1,
+				axisEncoder: shiftedAxisEncoder{},
+			},
+			args: args{
+				storage: models.NewBoard(
+					models.Size{
+						Width:  2,
+						Height: 2,
+					},
+				),
+			},
+			want: "++ 10\n" +
+				"++ 9 ",
+		},
 	} {
 		encoder := StoneStorageEncoder{
-			encoder:      data.fields.encoder,
-			placeholders: data.fields.placeholders,
-			margins:      data.fields.margins,
-			stoneWidth:   data.fields.stoneWidth,
+			encoder:       data.fields.encoder,
+			placeholders:  data.fields.placeholders,
+			margins:       data.fields.margins,
+			stoneWidth:    data.fields.stoneWidth,
+			legendEncoder: data.fields.legendEncoder,
+			lineEncoder:   data.fields.lineEncoder,
+			axisEncoder:   data.fields.axisEncoder,
+			starPoints:    data.fields.starPoints,
 		}
-		got := encoder.EncodeStoneStorage(data.args.storage)
+		got := encoder.EncodeStoneStorage(
+			data.args.storage,
+			data.args.pointEncoders...,
+		)
 
 		if got != data.want {
 			test.Fail()
 		}
 	}
 }
+
+func TestStoneStorageEncoderEncodePoint(test *testing.T) {
+	type args struct {
+		point         models.Point
+		pointEncoders []PointEncoder
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	board = board.ApplyMove(models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 1, Row: 0},
+	})
+
+	encoder := StoneStorageEncoder{
+		encoder: func(color models.Color) string {
+			return string(sgf.EncodeColor(color))
+		},
+		placeholders: Placeholders{
+			HorizontalLine: "-",
+			VerticalLine:   "|",
+			Crosshairs:     "+",
+		},
+		stoneWidth: 1,
+	}
+	for _, data := range []data{
+		{
+			args: args{
+				point: models.Point{Column: 1, Row: 0},
+			},
+			want: "W",
+		},
+		{
+			args: args{
+				point: models.Point{Column: 0, Row: 0},
+			},
+			want: "+",
+		},
+		{
+			args: args{
+				point: models.Point{Column: 0, Row: 0},
+				pointEncoders: []PointEncoder{
+					NewMarkedPointEncoder([]models.Point{{Column: 0, Row: 0}}, "x"),
+				},
+			},
+			want: "x",
+		},
+	} {
+		got := encoder.EncodePoint(board, data.args.point, data.args.pointEncoders...)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestStoneStorageEncoderLocatePoint(test *testing.T) {
+	type fields struct {
+		encoder     StoneEncoder
+		margins     Margins
+		stoneWidth  int
+		axisEncoder AxisEncoder
+	}
+	type args struct {
+		x int
+		y int
+	}
+	type data struct {
+		fields    fields
+		args      args
+		wantPoint models.Point
+		wantOk    bool
+	}
+
+	wideMargins := Margins{
+		Stone: StoneMargins{
+			HorizontalMargins: HorizontalMargins{
+				Left:  1,
+				Right: 1,
+			},
+			VerticalMargins: VerticalMargins{
+				Top:    1,
+				Bottom: 1,
+			},
+		},
+		Legend: LegendMargins{
+			Row: HorizontalMargins{
+				Right: 1,
+			},
+		},
+		Board: VerticalMargins{
+			Top: 1,
+		},
+	}
+	for _, data := range []data{
+		{
+			fields: fields{
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args:      args{x: 1, y: 0},
+			wantPoint: models.Point{Column: 0, Row: 2},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args:      args{x: 3, y: 2},
+			wantPoint: models.Point{Column: 2, Row: 0},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args:      args{x: 0, y: 0},
+			wantPoint: models.Point{},
+			wantOk:    false,
+		},
+		{
+			fields: fields{
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args:      args{x: 2, y: 3},
+			wantPoint: models.Point{},
+			wantOk:    false,
+		},
+		{
+			fields: fields{
+				margins:    wideMargins,
+				stoneWidth: 2,
+			},
+			args:      args{x: 6, y: 5},
+			wantPoint: models.Point{Column: 1, Row: 1},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins:    wideMargins,
+				stoneWidth: 2,
+			},
+			args:      args{x: 13, y: 9},
+			wantPoint: models.Point{Column: 2, Row: 0},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins:    wideMargins,
+				stoneWidth: 2,
+			},
+			args:      args{x: 6, y: 0},
+			wantPoint: models.Point{},
+			wantOk:    false,
+		},
+		{
+			fields: fields{
+				encoder: func(color models.Color) string {
+					return "\u26ab"
+				},
+				margins:    Margins{},
+				stoneWidth: 1,
+			},
+			args:      args{x: 3, y: 0},
+			wantPoint: models.Point{Column: 1, Row: 2},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins: Margins{
+					Legend: LegendMargins{
+						Placement: RightLegend | TopLegend,
+					},
+				},
+				stoneWidth: 1,
+			},
+			args:      args{x: 0, y: 1},
+			wantPoint: models.Point{Column: 0, Row: 2},
+			wantOk:    true,
+		},
+		{
+			fields: fields{
+				margins:     Margins{},
+				stoneWidth:  1,
+				axisEncoder: shiftedAxisEncoder{},
+			},
+			args:      args{x: 4, y: 0},
+			wantPoint: models.Point{Column: 1, Row: 2},
+			wantOk:    true,
+		},
+	} {
+		encoder := StoneStorageEncoder{
+			encoder:     data.fields.encoder,
+			margins:     data.fields.margins,
+			stoneWidth:  data.fields.stoneWidth,
+			axisEncoder: data.fields.axisEncoder,
+		}
+		gotPoint, gotOk := encoder.LocatePoint(
+			models.Size{Width: 3, Height: 3},
+			data.args.x,
+			data.args.y,
+		)
+
+		if gotPoint != data.wantPoint {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ascii
+
+import (
+	"regexp"
+	"unicode"
+)
+
+// nolint: gochecknoglobals
+var (
+	ansiEscapePattern = regexp.MustCompile("\x1b\\[[0-9;?<]*[a-zA-Z]")
+	// it's a simplified table of East Asian Wide and Fullwidth characters
+	// and of emoji with the default emoji presentation
+	wideRanges = []struct{ first, last rune }{
+		{0x1100, 0x115f},   // Hangul Jamo initial consonants
+		{0x231a, 0x231b},   // watch, hourglass
+		{0x23e9, 0x23ec},   // media buttons
+		{0x25fd, 0x25fe},   // medium small squares
+		{0x2614, 0x2615},   // umbrella, hot beverage
+		{0x26aa, 0x26ab},   // medium white and black circles
+		{0x26bd, 0x26be},   // soccer ball, baseball
+		{0x2b1b, 0x2b1c},   // large black and white squares
+		{0x2b50, 0x2b50},   // white medium star
+		{0x2b55, 0x2b55},   // heavy large circle
+		{0x2e80, 0x303e},   // CJK radicals, symbols and punctuation
+		{0x3041, 0x33ff},   // Hiragana, Katakana, CJK compatibility
+		{0x3400, 0x4dbf},   // CJK unified ideographs extension A
+		{0x4e00, 0x9fff},   // CJK unified ideographs
+		{0xa000, 0xa4cf},   // Yi
+		{0xac00, 0xd7a3},   // Hangul syllables
+		{0xf900, 0xfaff},   // CJK compatibility ideographs
+		{0xfe30, 0xfe4f},   // CJK compatibility forms
+		{0xff00, 0xff60},   // fullwidth forms
+		{0xffe0, 0xffe6},   // fullwidth signs
+		{0x1f300, 0x1f64f}, // miscellaneous symbols and pictographs, emoticons
+		{0x1f680, 0x1f6ff}, // transport and map symbols
+		{0x1f7e0, 0x1f7eb}, // large colored circles and squares
+		{0x1f900, 0x1f9ff}, // supplemental symbols and pictographs
+		{0x20000, 0x3fffd}, // CJK unified ideographs extensions
+	}
+)
+
+// TextWidth ...
+//
+// It returns a display width of the text in terminal columns. It ignores
+// ANSI escape sequences, counts wide characters (e.g. CJK ones or emoji)
+// as two columns and combining and format characters as zero columns.
+//
+func TextWidth(text string) int {
+	var width int
+	for _, symbol := range ansiEscapePattern.ReplaceAllString(text, "") {
+		width += symbolWidth(symbol)
+	}
+
+	return width
+}
+
+func symbolWidth(symbol rune) int {
+	if unicode.In(symbol, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+
+	for _, wideRange := range wideRanges {
+		if symbol >= wideRange.first && symbol <= wideRange.last {
+			return 2
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,60 @@
+package ascii
+
+import (
+	"testing"
+)
+
+func TestTextWidth(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{""},
+			want: 0,
+		},
+		{
+			args: args{"+-B"},
+			want: 3,
+		},
+		{
+			args: args{"●┼"},
+			want: 2,
+		},
+		{
+			args: args{"\x1b[38;2;1;2;3m●\x1b[39m"},
+			want: 1,
+		},
+		{
+			args: args{"⚫⚪"},
+			want: 4,
+		},
+		{
+			args: args{"\U0001f7e2"},
+			want: 2,
+		},
+		{
+			args: args{"黒白"},
+			want: 4,
+		},
+		{
+			args: args{"é"},
+			want: 1,
+		},
+		{
+			args: args{"⚫️"},
+			want: 2,
+		},
+	} {
+		got := TextWidth(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package coordinates_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/coordinates"
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func ExampleGTPSystem_EncodePoint() {
+	point := coordinates.GTPSystem{}.EncodePoint(models.Point{Column: 8, Row: 2})
+	fmt.Printf("%v\n", point)
+
+	// Output: J3
+}
+
+func ExampleGTPSystem_DecodePoint() {
+	point, _ := coordinates.GTPSystem{}.DecodePoint("j3")
+	fmt.Printf("%+v\n", point)
+
+	// Output: {Column:8 Row:2}
+}
@@ -0,0 +1,78 @@
+package coordinates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+const (
+	// the letter I is skipped to avoid confusion with the digit 1
+	gtpColumnLetters = "ABCDEFGHJKLMNOPQRSTUVWXYZ"
+	gtpColumnBase    = len(gtpColumnLetters)
+)
+
+// GTPSystem ...
+//
+// It uses points like "C2" of Go Text Protocol: a column letter (skipping I)
+// and a one-based row number. Columns beyond Z are encoded by several
+// letters (e.g. "AA").
+//
+type GTPSystem struct{}
+
+// EncodeColumn ...
+func (system GTPSystem) EncodeColumn(column int) string {
+	var text string
+	for number := column + 1; number > 0; number = (number - 1) / gtpColumnBase {
+		text = string(gtpColumnLetters[(number-1)%gtpColumnBase]) + text
+	}
+
+	return text
+}
+
+// EncodeRow ...
+func (system GTPSystem) EncodeRow(row int) string {
+	return strconv.Itoa(row + 1)
+}
+
+// EncodePoint ...
+func (system GTPSystem) EncodePoint(point models.Point) string {
+	return system.EncodeColumn(point.Column) + system.EncodeRow(point.Row)
+}
+
+// DecodePoint ...
+//
+// It's case-insensitive.
+//
+func (system GTPSystem) DecodePoint(text string) (models.Point, error) {
+	text = strings.ToUpper(strings.TrimSpace(text))
+
+	digitsIndex := strings.IndexAny(text, "0123456789")
+	if digitsIndex <= 0 {
+		return models.Point{}, errors.New("incorrect point format")
+	}
+
+	var columnNumber int
+	for _, symbol := range text[:digitsIndex] {
+		index := strings.IndexRune(gtpColumnLetters, symbol)
+		if index == -1 {
+			return models.Point{}, fmt.Errorf("incorrect column letter %q", symbol)
+		}
+
+		columnNumber = columnNumber*gtpColumnBase + index + 1
+	}
+
+	rowNumber, err := strconv.Atoi(text[digitsIndex:])
+	if err != nil || rowNumber <= 0 {
+		return models.Point{}, errors.New("incorrect row number")
+	}
+
+	point := models.Point{
+		Column: columnNumber - 1,
+		Row:    rowNumber - 1,
+	}
+	return point, nil
+}
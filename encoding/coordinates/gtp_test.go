@@ -0,0 +1,117 @@
+package coordinates
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestGTPSystemEncodeColumn(test *testing.T) {
+	type args struct {
+		column int
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{0},
+			want: "A",
+		},
+		{
+			args: args{8},
+			want: "J",
+		},
+		{
+			args: args{24},
+			want: "Z",
+		},
+		{
+			args: args{25},
+			want: "AA",
+		},
+		{
+			args: args{50},
+			want: "BA",
+		},
+	} {
+		got := GTPSystem{}.EncodeColumn(data.args.column)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestGTPSystemEncodePoint(test *testing.T) {
+	got := GTPSystem{}.EncodePoint(models.Point{Column: 8, Row: 11})
+
+	if got != "J12" {
+		test.Fail()
+	}
+}
+
+func TestGTPSystemDecodePoint(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    models.Point
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"C2"},
+			want:    models.Point{Column: 2, Row: 1},
+			wantErr: false,
+		},
+		{
+			args:    args{"j12"},
+			want:    models.Point{Column: 8, Row: 11},
+			wantErr: false,
+		},
+		{
+			args:    args{"AA1"},
+			want:    models.Point{Column: 25, Row: 0},
+			wantErr: false,
+		},
+		{
+			args:    args{"I3"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"3"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"C"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"C0"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"C2x"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+	} {
+		got, gotErr := GTPSystem{}.DecodePoint(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package coordinates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// NumericSystem ...
+//
+// It uses points like "2,3" of one-based numbers: a row and a column.
+//
+type NumericSystem struct{}
+
+// EncodeColumn ...
+func (system NumericSystem) EncodeColumn(column int) string {
+	return strconv.Itoa(column + 1)
+}
+
+// EncodeRow ...
+func (system NumericSystem) EncodeRow(row int) string {
+	return strconv.Itoa(row + 1)
+}
+
+// EncodePoint ...
+func (system NumericSystem) EncodePoint(point models.Point) string {
+	return system.EncodeRow(point.Row) + "," + system.EncodeColumn(point.Column)
+}
+
+// DecodePoint ...
+func (system NumericSystem) DecodePoint(text string) (models.Point, error) {
+	parts := strings.Split(text, ",")
+	if len(parts) != 2 {
+		return models.Point{}, errors.New("incorrect point format")
+	}
+
+	var numbers []int
+	for _, part := range parts {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return models.Point{}, fmt.Errorf("incorrect number: %s", err)
+		}
+		if number <= 0 {
+			return models.Point{}, errors.New("non-positive number")
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	point := models.Point{
+		Column: numbers[1] - 1,
+		Row:    numbers[0] - 1,
+	}
+	return point, nil
+}
@@ -0,0 +1,69 @@
+package coordinates
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestNumericSystemEncodePoint(test *testing.T) {
+	system := NumericSystem{}
+
+	if got := system.EncodeColumn(2); got != "3" {
+		test.Fail()
+	}
+	if got := system.EncodeRow(11); got != "12" {
+		test.Fail()
+	}
+	if got := system.EncodePoint(models.Point{Column: 2, Row: 11}); got != "12,3" {
+		test.Fail()
+	}
+}
+
+func TestNumericSystemDecodePoint(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    models.Point
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"12,3"},
+			want:    models.Point{Column: 2, Row: 11},
+			wantErr: false,
+		},
+		{
+			args:    args{" 1, 2 "},
+			want:    models.Point{Column: 1, Row: 0},
+			wantErr: false,
+		},
+		{
+			args:    args{"1"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"1,x"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+		{
+			args:    args{"0,1"},
+			want:    models.Point{},
+			wantErr: true,
+		},
+	} {
+		got, gotErr := NumericSystem{}.DecodePoint(data.args.text)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
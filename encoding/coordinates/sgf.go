@@ -0,0 +1,32 @@
+package coordinates
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+// SGFSystem ...
+//
+// It uses letter pairs like "cb" of Smart Game Format.
+//
+type SGFSystem struct{}
+
+// EncodeColumn ...
+func (system SGFSystem) EncodeColumn(column int) string {
+	return string(sgf.EncodeAxis(column))
+}
+
+// EncodeRow ...
+func (system SGFSystem) EncodeRow(row int) string {
+	return string(sgf.EncodeAxis(row))
+}
+
+// EncodePoint ...
+func (system SGFSystem) EncodePoint(point models.Point) string {
+	return sgf.EncodePoint(point)
+}
+
+// DecodePoint ...
+func (system SGFSystem) DecodePoint(text string) (models.Point, error) {
+	return sgf.DecodePoint(text)
+}
@@ -0,0 +1,26 @@
+package coordinates
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestSGFSystem(test *testing.T) {
+	system := SGFSystem{}
+
+	if got := system.EncodeColumn(2); got != "c" {
+		test.Fail()
+	}
+	if got := system.EncodeRow(1); got != "b" {
+		test.Fail()
+	}
+	if got := system.EncodePoint(models.Point{Column: 2, Row: 1}); got != "cb" {
+		test.Fail()
+	}
+
+	got, err := system.DecodePoint("cb")
+	if got != (models.Point{Column: 2, Row: 1}) || err != nil {
+		test.Fail()
+	}
+}
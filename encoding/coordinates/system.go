@@ -0,0 +1,38 @@
+package coordinates
+
+import (
+	"errors"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// System ...
+//
+// Rows are counted from the bottom of the board.
+//
+type System interface {
+	EncodeColumn(column int) string
+	EncodeRow(row int) string
+	EncodePoint(point models.Point) string
+	DecodePoint(text string) (models.Point, error)
+}
+
+// DecodeSystem ...
+//
+// It supports the following names: "sgf", "gtp" and "numeric".
+//
+func DecodeSystem(name string) (System, error) {
+	var system System
+	switch name {
+	case "sgf":
+		system = SGFSystem{}
+	case "gtp":
+		system = GTPSystem{}
+	case "numeric":
+		system = NumericSystem{}
+	default:
+		return nil, errors.New("unknown coordinate system")
+	}
+
+	return system, nil
+}
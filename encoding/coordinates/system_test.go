@@ -0,0 +1,49 @@
+package coordinates
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeSystem(test *testing.T) {
+	type args struct {
+		name string
+	}
+	type data struct {
+		args    args
+		want    System
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"sgf"},
+			want:    SGFSystem{},
+			wantErr: false,
+		},
+		{
+			args:    args{"gtp"},
+			want:    GTPSystem{},
+			wantErr: false,
+		},
+		{
+			args:    args{"numeric"},
+			want:    NumericSystem{},
+			wantErr: false,
+		},
+		{
+			args:    args{"unknown"},
+			want:    nil,
+			wantErr: true,
+		},
+	} {
+		got, gotErr := DecodeSystem(data.args.name)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package report_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/report"
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func ExampleTurn_Encode() {
+	board := models.NewBoard(models.Size{Width: 2, Height: 2})
+	move := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 0},
+	}
+	board = board.ApplyMove(move)
+
+	turn := report.NewTurn(board, models.White, &move, nil, nil)
+	text, _ := turn.Encode()
+	fmt.Printf("%v\n", text)
+
+	// Output:
+	// {"board":[["","B"],["",""]],"to_move":"white","last_move":"ba"}
+}
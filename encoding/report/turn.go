@@ -0,0 +1,142 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-cli/encoding/ascii"
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+// Engine ...
+//
+// It describes the search of an engine move.
+//
+type Engine struct {
+	Games    int     `json:"games"`
+	Wins     int     `json:"wins"`
+	WinRate  float64 `json:"win_rate"`
+	Duration float64 `json:"duration"` // in seconds
+	// it's empty, if the position wasn't solved exactly; a proven win
+	// is chosen without games, so the statistics above are zeros then
+	Proof string `json:"proof,omitempty"`
+	// the move is taken from the opening book without a search
+	Book bool `json:"book,omitempty"`
+}
+
+// NewEngine ...
+func NewEngine(games int, wins int, duration time.Duration) Engine {
+	var winRate float64
+	if games != 0 {
+		winRate = float64(wins) / float64(games)
+	}
+
+	return Engine{
+		Games:    games,
+		Wins:     wins,
+		WinRate:  winRate,
+		Duration: duration.Seconds(),
+	}
+}
+
+// Clock ...
+//
+// It describes a clock of a player.
+//
+type Clock struct {
+	MainTime float64 `json:"main_time"` // remaining, in seconds
+	// remaining byo-yomi periods
+	Periods int `json:"periods,omitempty"`
+}
+
+// NewClock ...
+func NewClock(mainTime time.Duration, periods int) Clock {
+	return Clock{
+		MainTime: mainTime.Seconds(),
+		Periods:  periods,
+	}
+}
+
+// Turn ...
+//
+// It describes a game state after a move for a structured output.
+//
+type Turn struct {
+	// rows are ordered from the bottom, as in models.Point;
+	// each item is "B", "W" or empty
+	Board  [][]string `json:"board"`
+	ToMove string     `json:"to_move"`
+	// in Smart Game Format; it's empty for the initial position
+	LastMove string  `json:"last_move,omitempty"`
+	Engine   *Engine `json:"engine,omitempty"`
+	// keys are colors; it's empty, if time controls aren't used
+	Clocks map[string]Clock `json:"clocks,omitempty"`
+	// it's empty until the game is over
+	Winner string `json:"winner,omitempty"`
+}
+
+// NewTurn ...
+//
+// The last move and engine are optional. The game error should be
+// a result of models.MoveGenerator.LegalMoves() for the color to move.
+//
+func NewTurn(
+	storage models.StoneStorage,
+	toMove models.Color,
+	lastMove *models.Move,
+	engine *Engine,
+	gameErr error,
+) Turn {
+	turn := Turn{
+		Board:  EncodeBoard(storage),
+		ToMove: ascii.EncodeColor(toMove),
+		Engine: engine,
+	}
+	if lastMove != nil {
+		turn.LastMove = sgf.EncodePoint(lastMove.Point)
+	}
+
+	switch gameErr {
+	case models.ErrAlreadyWin:
+		turn.Winner = ascii.EncodeColor(toMove)
+	case models.ErrAlreadyLoss:
+		turn.Winner = ascii.EncodeColor(toMove.Negative())
+	}
+
+	return turn
+}
+
+// Encode ...
+//
+// It encodes the turn to JSON in a single line.
+//
+func (turn Turn) Encode() (string, error) {
+	data, err := json.Marshal(turn)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal the turn: %s", err)
+	}
+
+	return string(data), nil
+}
+
+// EncodeBoard ...
+//
+// It encodes the storage as a matrix indexed by a row and then by a column.
+//
+func EncodeBoard(storage models.StoneStorage) [][]string {
+	size := storage.Size()
+	board := make([][]string, size.Height)
+	for row := range board {
+		board[row] = make([]string, size.Width)
+	}
+
+	for _, point := range size.Points() {
+		if color, ok := storage.Stone(point); ok {
+			board[point.Row][point.Column] = string(sgf.EncodeColor(color))
+		}
+	}
+
+	return board
+}
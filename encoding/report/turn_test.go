@@ -0,0 +1,210 @@
+package report
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestNewEngine(test *testing.T) {
+	type args struct {
+		games    int
+		wins     int
+		duration time.Duration
+	}
+	type data struct {
+		args args
+		want Engine
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				games:    0,
+				wins:     0,
+				duration: 0,
+			},
+			want: Engine{},
+		},
+		{
+			args: args{
+				games:    8,
+				wins:     6,
+				duration: 1500 * time.Millisecond,
+			},
+			want: Engine{
+				Games:    8,
+				Wins:     6,
+				WinRate:  0.75,
+				Duration: 1.5,
+			},
+		},
+	} {
+		got := NewEngine(data.args.games, data.args.wins, data.args.duration)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewClock(test *testing.T) {
+	got := NewClock(1500*time.Millisecond, 2)
+
+	want := Clock{MainTime: 1.5, Periods: 2}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestNewTurn(test *testing.T) {
+	type args struct {
+		toMove   models.Color
+		lastMove *models.Move
+		engine   *Engine
+		gameErr  error
+	}
+	type data struct {
+		args args
+		want Turn
+	}
+
+	board := models.NewBoard(models.Size{Width: 2, Height: 2})
+	board = board.ApplyMove(models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 0},
+	})
+
+	for _, data := range []data{
+		{
+			args: args{
+				toMove:   models.Black,
+				lastMove: nil,
+				engine:   nil,
+				gameErr:  nil,
+			},
+			want: Turn{
+				Board:  [][]string{{"", "B"}, {"", ""}},
+				ToMove: "black",
+			},
+		},
+		{
+			args: args{
+				toMove: models.White,
+				lastMove: &models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: 1, Row: 0},
+				},
+				engine:  &Engine{Games: 2, Wins: 1, WinRate: 0.5},
+				gameErr: nil,
+			},
+			want: Turn{
+				Board:    [][]string{{"", "B"}, {"", ""}},
+				ToMove:   "white",
+				LastMove: "ba",
+				Engine:   &Engine{Games: 2, Wins: 1, WinRate: 0.5},
+			},
+		},
+		{
+			args: args{
+				toMove:   models.White,
+				lastMove: nil,
+				engine:   nil,
+				gameErr:  models.ErrAlreadyWin,
+			},
+			want: Turn{
+				Board:  [][]string{{"", "B"}, {"", ""}},
+				ToMove: "white",
+				Winner: "white",
+			},
+		},
+		{
+			args: args{
+				toMove:   models.White,
+				lastMove: nil,
+				engine:   nil,
+				gameErr:  models.ErrAlreadyLoss,
+			},
+			want: Turn{
+				Board:  [][]string{{"", "B"}, {"", ""}},
+				ToMove: "white",
+				Winner: "black",
+			},
+		},
+		{
+			args: args{
+				toMove:   models.White,
+				lastMove: nil,
+				engine:   nil,
+				gameErr:  errors.New("dummy"),
+			},
+			want: Turn{
+				Board:  [][]string{{"", "B"}, {"", ""}},
+				ToMove: "white",
+			},
+		},
+	} {
+		got := NewTurn(
+			board,
+			data.args.toMove,
+			data.args.lastMove,
+			data.args.engine,
+			data.args.gameErr,
+		)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestTurnEncode(test *testing.T) {
+	turn := Turn{
+		Board:    [][]string{{"", "B"}, {"W", ""}},
+		ToMove:   "white",
+		LastMove: "ba",
+		Engine: &Engine{
+			Games:    4,
+			Wins:     3,
+			WinRate:  0.75,
+			Duration: 0.5,
+			Proof:    "proven win",
+		},
+		Clocks: map[string]Clock{
+			"black": {MainTime: 0, Periods: 2},
+			"white": {MainTime: 60.5},
+		},
+	}
+	got, err := turn.Encode()
+
+	want := `{"board":[["","B"],["W",""]],"to_move":"white","last_move":"ba",` +
+		`"engine":{"games":4,"wins":3,"win_rate":0.75,"duration":0.5,` +
+		`"proof":"proven win"},"clocks":{"black":{"main_time":0,"periods":2},` +
+		`"white":{"main_time":60.5}}}`
+	if got != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodeBoard(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 2})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 2, Row: 1}},
+	} {
+		board = board.ApplyMove(move)
+	}
+
+	got := EncodeBoard(board)
+
+	want := [][]string{{"B", "", ""}, {"", "", "W"}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
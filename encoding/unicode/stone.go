@@ -16,3 +16,16 @@ func EncodeStone(color models.Color) string {
 
 	return text
 }
+
+// EncodeMarkedStone ...
+func EncodeMarkedStone(color models.Color) string {
+	var text string
+	switch color {
+	case models.Black:
+		text = "◉"
+	case models.White:
+		text = "◎"
+	}
+
+	return text
+}
@@ -32,3 +32,30 @@ func TestEncodeStone(test *testing.T) {
 		}
 	}
 }
+
+func TestEncodeMarkedStone(test *testing.T) {
+	type args struct {
+		color models.Color
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{models.Black},
+			want: "◉",
+		},
+		{
+			args: args{models.White},
+			want: "◎",
+		},
+	} {
+		got := EncodeMarkedStone(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
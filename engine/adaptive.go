@@ -0,0 +1,168 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// BuildingProgress ...
+//
+// It merges game counts of children of root copies built concurrently
+// (e.g. by the parallel builder), so AdaptiveBuilder makes its decisions
+// by the merged tree instead of a partial copy. It's safe for concurrent
+// use.
+//
+type BuildingProgress struct {
+	mutex  sync.Mutex
+	counts map[*tree.Node]map[models.Move]int
+}
+
+// NewBuildingProgress ...
+func NewBuildingProgress() *BuildingProgress {
+	return &BuildingProgress{
+		counts: make(map[*tree.Node]map[models.Move]int),
+	}
+}
+
+// Update ...
+//
+// It stores game counts of children of the root copy and returns game
+// counts of children of the merged tree in an arbitrary order.
+//
+func (progress *BuildingProgress) Update(root *tree.Node) []int {
+	rootCounts := make(map[models.Move]int)
+	for _, child := range root.Children {
+		rootCounts[child.Move] = child.State.GameCount
+	}
+
+	progress.mutex.Lock()
+	defer progress.mutex.Unlock()
+
+	progress.counts[root] = rootCounts
+
+	mergedCounts := make(map[models.Move]int)
+	for _, copyCounts := range progress.counts {
+		for move, count := range copyCounts {
+			mergedCounts[move] += count
+		}
+	}
+
+	gameCounts := make([]int, 0, len(mergedCounts))
+	for _, count := range mergedCounts {
+		gameCounts = append(gameCounts, count)
+	}
+
+	return gameCounts
+}
+
+// AdaptiveBuilder ...
+//
+// It's an analog of builders.IterativeBuilder with pass and time limits,
+// which adapts the time limit to the search state. It stops the building
+// early, when the best child of the root by game counts can't be overtaken
+// within the remaining time at the observed building speed. And it extends
+// the time limit once, when game counts of the two best children are close.
+// So a move should be selected by game counts after it.
+//
+type AdaptiveBuilder struct {
+	Builder     builders.Builder
+	Clock       func() time.Time
+	MaximalPass int
+	Duration    time.Duration
+	Extension   time.Duration
+	// the two best children are close, if the game count of the second one
+	// is at least this share of the game count of the first one
+	CloseRatio float64
+	// it's required, if copies of the root are built concurrently
+	// (e.g. by the parallel builder); nil value means the root isn't copied
+	Progress *BuildingProgress
+}
+
+// Pass ...
+func (builder AdaptiveBuilder) Pass(root *tree.Node) {
+	startTime := builder.Clock()
+	deadline := startTime.Add(builder.Duration)
+	extended := false
+	for pass := 0; pass < builder.MaximalPass; pass++ {
+		builder.Builder.Pass(root)
+
+		gameCounts := builder.gameCounts(root)
+		now := builder.Clock()
+		if !now.Before(deadline) {
+			if extended || !builder.hasCloseCandidates(gameCounts) {
+				return
+			}
+
+			deadline = deadline.Add(builder.Extension)
+			extended = true
+
+			continue
+		}
+
+		if isDecided(gameCounts, now.Sub(startTime), deadline.Sub(now)) {
+			return
+		}
+	}
+}
+
+func (builder AdaptiveBuilder) gameCounts(root *tree.Node) []int {
+	if builder.Progress != nil {
+		return builder.Progress.Update(root)
+	}
+
+	gameCounts := make([]int, 0, len(root.Children))
+	for _, child := range root.Children {
+		gameCounts = append(gameCounts, child.State.GameCount)
+	}
+
+	return gameCounts
+}
+
+func (builder AdaptiveBuilder) hasCloseCandidates(gameCounts []int) bool {
+	first, second := bestGameCounts(gameCounts)
+	return first != 0 && float64(second) >= builder.CloseRatio*float64(first)
+}
+
+// the games remaining for the building are estimated by the speed
+// of the building so far
+func isDecided(
+	gameCounts []int,
+	elapsedTime time.Duration,
+	remainingTime time.Duration,
+) bool {
+	// there is no choice for a single move
+	if len(gameCounts) == 1 {
+		return true
+	}
+	if elapsedTime <= 0 {
+		return false
+	}
+
+	var totalGameCount int
+	for _, gameCount := range gameCounts {
+		totalGameCount += gameCount
+	}
+
+	speed := float64(totalGameCount) / elapsedTime.Seconds()
+	remainingGames := speed * remainingTime.Seconds()
+	first, second := bestGameCounts(gameCounts)
+	return first != 0 && float64(first-second) > remainingGames
+}
+
+// it returns the two largest game counts; the missed ones are zeros
+func bestGameCounts(gameCounts []int) (first int, second int) {
+	for _, gameCount := range gameCounts {
+		switch {
+		case gameCount > first:
+			first, second = gameCount, first
+		case gameCount > second:
+			second = gameCount
+		}
+	}
+
+	return first, second
+}
@@ -0,0 +1,203 @@
+package engine
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type sequenceBuilder struct {
+	// indices of children of the root simulated by passes in turn
+	sequence []int
+	passes   *int
+}
+
+func (builder sequenceBuilder) Pass(root *tree.Node) {
+	index := builder.sequence[*builder.passes%len(builder.sequence)]
+	root.Children[index].State.GameCount++
+	*builder.passes++
+}
+
+func TestAdaptiveBuilderPass(test *testing.T) {
+	type fields struct {
+		sequence    []int
+		maximalPass int
+		closeRatio  float64
+	}
+	type args struct {
+		childCount int
+	}
+	type data struct {
+		fields     fields
+		args       args
+		wantPasses int
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				sequence:    []int{0},
+				maximalPass: 100,
+				closeRatio:  0.9,
+			},
+			args:       args{2},
+			wantPasses: 6,
+		},
+		{
+			fields: fields{
+				sequence:    []int{0, 0, 1},
+				maximalPass: 100,
+				closeRatio:  0.9,
+			},
+			args:       args{2},
+			wantPasses: 8,
+		},
+		{
+			fields: fields{
+				sequence:    []int{0, 1},
+				maximalPass: 100,
+				closeRatio:  0.9,
+			},
+			args:       args{2},
+			wantPasses: 15,
+		},
+		{
+			fields: fields{
+				sequence:    []int{0, 1},
+				maximalPass: 100,
+				closeRatio:  1.1,
+			},
+			args:       args{2},
+			wantPasses: 10,
+		},
+		{
+			fields: fields{
+				sequence:    []int{0, 1},
+				maximalPass: 3,
+				closeRatio:  0.9,
+			},
+			args:       args{2},
+			wantPasses: 3,
+		},
+		{
+			fields: fields{
+				sequence:    []int{0},
+				maximalPass: 100,
+				closeRatio:  0.9,
+			},
+			args:       args{1},
+			wantPasses: 1,
+		},
+	} {
+		root := &tree.Node{}
+		for index := 0; index < data.args.childCount; index++ {
+			root.Children = append(root.Children, &tree.Node{Parent: root})
+		}
+
+		// each call of the clock takes a second
+		now := time.Unix(0, 0)
+		var passes int
+		builder := AdaptiveBuilder{
+			Builder: sequenceBuilder{
+				sequence: data.fields.sequence,
+				passes:   &passes,
+			},
+			Clock: func() time.Time {
+				now = now.Add(time.Second)
+				return now
+			},
+			MaximalPass: data.fields.maximalPass,
+			Duration:    10 * time.Second,
+			Extension:   5 * time.Second,
+			CloseRatio:  data.fields.closeRatio,
+		}
+		builder.Pass(root)
+
+		if passes != data.wantPasses {
+			test.Fail()
+		}
+	}
+}
+
+func TestBuildingProgressUpdate(test *testing.T) {
+	newRoot := func(gameCounts ...int) *tree.Node {
+		root := &tree.Node{}
+		for column, gameCount := range gameCounts {
+			root.Children = append(root.Children, &tree.Node{
+				Parent: root,
+				Move: models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: column, Row: 0},
+				},
+				State: tree.NodeState{GameCount: gameCount},
+			})
+		}
+
+		return root
+	}
+
+	progress := NewBuildingProgress()
+	firstCopy, secondCopy := newRoot(3, 1), newRoot(0, 2, 5)
+	progress.Update(firstCopy)
+	progress.Update(secondCopy)
+
+	// the game counts of the copy are replaced
+	firstCopy.Children[0].State.GameCount = 4
+	got := progress.Update(firstCopy)
+	sort.Ints(got)
+
+	if !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		test.Fail()
+	}
+}
+
+func TestAdaptiveBuilderPassWithProgress(test *testing.T) {
+	// the other copy makes the two children close, so the building
+	// isn't stopped early and is extended
+	otherCopy := &tree.Node{}
+	progress := NewBuildingProgress()
+	for column, gameCount := range []int{0, 100} {
+		otherCopy.Children = append(otherCopy.Children, &tree.Node{
+			Move:  models.Move{Point: models.Point{Column: column, Row: 0}},
+			State: tree.NodeState{GameCount: gameCount},
+		})
+	}
+	progress.Update(otherCopy)
+
+	root := &tree.Node{}
+	for column := 0; column < 2; column++ {
+		root.Children = append(root.Children, &tree.Node{
+			Parent: root,
+			Move:   models.Move{Point: models.Point{Column: column, Row: 0}},
+		})
+	}
+
+	// each call of the clock takes a second
+	now := time.Unix(0, 0)
+	var passes int
+	builder := AdaptiveBuilder{
+		Builder: sequenceBuilder{
+			sequence: []int{0},
+			passes:   &passes,
+		},
+		Clock: func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		},
+		MaximalPass: 1000,
+		Duration:    100 * time.Second,
+		Extension:   50 * time.Second,
+		CloseRatio:  0.9,
+		Progress:    progress,
+	}
+	builder.Pass(root)
+
+	// without the other copy, the building would be stopped after 51 passes
+	if passes <= 51 {
+		test.Fail()
+	}
+}
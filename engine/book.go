@@ -0,0 +1,276 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-models/encoding/sgf"
+)
+
+// BookEntry ...
+//
+// It's a reply recommended by an opening book. Replies are chosen
+// in proportion to their weights.
+//
+type BookEntry struct {
+	Move   models.Move
+	Weight int
+}
+
+// Book ...
+//
+// It maps positions to recommended replies. Positions are identified
+// by Zobrist hashes of themselves and colors to move, so replies are shared
+// between transpositions, and a book is valid only for a single board size.
+//
+type Book struct {
+	size    models.Size
+	hasher  ZobristHasher
+	entries map[uint64][]BookEntry
+}
+
+// NewBook ...
+func NewBook(size models.Size) Book {
+	return Book{
+		size:    size,
+		hasher:  NewZobristHasher(size),
+		entries: make(map[uint64][]BookEntry),
+	}
+}
+
+// DecodeBook ...
+//
+// It decodes a book from the text in the format described in Encode().
+// Empty lines and lines started with "#" are ignored.
+//
+func DecodeBook(text string) (Book, error) {
+	var book Book
+	var hasSize bool
+	for index, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !hasSize {
+			var size models.Size
+			_, err := fmt.Sscanf(line, "size %dx%d", &size.Width, &size.Height)
+			if err != nil {
+				return Book{}, fmt.Errorf("unable to decode the size: %s", err)
+			}
+			if size.Width <= 0 || size.Height <= 0 {
+				return Book{}, fmt.Errorf(
+					"incorrect size %dx%d",
+					size.Width,
+					size.Height,
+				)
+			}
+
+			book = NewBook(size)
+			hasSize = true
+
+			continue
+		}
+
+		hash, entry, err := decodeBookEntry(line, book.size)
+		if err != nil {
+			return Book{}, fmt.Errorf(
+				"unable to decode the entry on line %d: %s",
+				index+1,
+				err,
+			)
+		}
+
+		book.add(hash, entry)
+	}
+	if !hasSize {
+		return Book{}, errors.New("missed size")
+	}
+
+	return book, nil
+}
+
+// Size ...
+func (book Book) Size() models.Size {
+	return book.size
+}
+
+// Add ...
+//
+// It adds the reply to the position or increases the weight of the reply,
+// if it's already added. The storage should have the same size as the book.
+//
+func (book Book) Add(
+	storage models.StoneStorage,
+	move models.Move,
+	weight int,
+) {
+	hash := book.hasher.Hash(storage, move.Color)
+	book.add(hash, BookEntry{Move: move, Weight: weight})
+}
+
+// Entries ...
+//
+// It returns legal replies to the position ordered by their weights.
+// Illegal replies (e.g. because of hash collisions) are skipped.
+//
+func (book Book) Entries(
+	storage models.StoneStorage,
+	color models.Color,
+) []BookEntry {
+	if storage.Size() != book.size {
+		return nil
+	}
+
+	var entries []BookEntry
+	hash := book.hasher.Hash(storage, color)
+	for _, entry := range book.entries[hash] {
+		if entry.Move.Color != color || storage.CheckMove(entry.Move) != nil {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i int, j int) bool {
+		return entries[i].Weight > entries[j].Weight
+	})
+
+	return entries
+}
+
+// Lookup ...
+//
+// It chooses a reply to the position randomly in proportion to weights
+// of the replies. The randomizer should return a number in [0, n).
+// It returns false as the second result, if there are no replies.
+//
+func (book Book) Lookup(
+	storage models.StoneStorage,
+	color models.Color,
+	randomizer func(n int) int,
+) (move models.Move, ok bool) {
+	entries := book.Entries(storage, color)
+	if len(entries) == 0 {
+		return models.Move{}, false
+	}
+
+	var totalWeight int
+	for _, entry := range entries {
+		totalWeight += entry.Weight
+	}
+
+	number := randomizer(totalWeight)
+	for _, entry := range entries {
+		if number < entry.Weight {
+			return entry.Move, true
+		}
+
+		number -= entry.Weight
+	}
+
+	// it's unreachable for a correct randomizer
+	return entries[0].Move, true
+}
+
+// Encode ...
+//
+// It encodes the book as text. The first line is "size WxH", and each next
+// line is an entry like "0123456789abcdef B cc 10", i.e. a hexadecimal hash
+// of the position, a color and a point in Smart Game Format of the reply
+// and its weight. Entries are ordered by their hashes and then by
+// their weights.
+//
+func (book Book) Encode() string {
+	var hashes []uint64
+	for hash := range book.entries {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i int, j int) bool {
+		return hashes[i] < hashes[j]
+	})
+
+	lines := []string{
+		fmt.Sprintf("size %dx%d", book.size.Width, book.size.Height),
+	}
+	for _, hash := range hashes {
+		entries := append([]BookEntry(nil), book.entries[hash]...)
+		sort.SliceStable(entries, func(i int, j int) bool {
+			return entries[i].Weight > entries[j].Weight
+		})
+
+		for _, entry := range entries {
+			lines = append(lines, fmt.Sprintf(
+				"%016x %c %s %d",
+				hash,
+				sgf.EncodeColor(entry.Move.Color),
+				sgf.EncodePoint(entry.Move.Point),
+				entry.Weight,
+			))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (book Book) add(hash uint64, entry BookEntry) {
+	for index, existingEntry := range book.entries[hash] {
+		if existingEntry.Move == entry.Move {
+			book.entries[hash][index].Weight += entry.Weight
+			return
+		}
+	}
+
+	book.entries[hash] = append(book.entries[hash], entry)
+}
+
+func decodeBookEntry(
+	line string,
+	size models.Size,
+) (hash uint64, entry BookEntry, err error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 {
+		return 0, BookEntry{}, fmt.Errorf("incorrect field count %d", len(fields))
+	}
+
+	hash, err = strconv.ParseUint(fields[0], 16, 64)
+	if err != nil {
+		return 0, BookEntry{}, fmt.Errorf("unable to decode the hash: %s", err)
+	}
+
+	var color models.Color
+	switch fields[1] {
+	case string(sgf.EncodeColor(models.Black)):
+		color = models.Black
+	case string(sgf.EncodeColor(models.White)):
+		color = models.White
+	default:
+		return 0, BookEntry{}, fmt.Errorf("incorrect color %q", fields[1])
+	}
+
+	point, err := sgf.DecodePoint(fields[2])
+	if err != nil {
+		return 0, BookEntry{}, fmt.Errorf("unable to decode the point: %s", err)
+	}
+	if point.Column >= size.Width || point.Row >= size.Height {
+		return 0, BookEntry{}, fmt.Errorf("point %q is out of the board", fields[2])
+	}
+
+	weight, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return 0, BookEntry{}, fmt.Errorf("unable to decode the weight: %s", err)
+	}
+	if weight <= 0 {
+		return 0, BookEntry{}, fmt.Errorf("non-positive weight %d", weight)
+	}
+
+	entry = BookEntry{
+		Move:   models.Move{Color: color, Point: point},
+		Weight: weight,
+	}
+	return hash, entry, nil
+}
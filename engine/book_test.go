@@ -0,0 +1,231 @@
+package engine
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestDecodeBook(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    []BookEntry
+		wantErr bool
+	}
+
+	size := models.Size{Width: 3, Height: 3}
+	storage := models.NewBoard(size)
+	hash := NewZobristHasher(size).Hash(storage, models.Black)
+	entry := func(weight int) string {
+		return fmt.Sprintf("%016x B bb %d", hash, weight)
+	}
+	for _, data := range []data{
+		{
+			args: args{
+				text: "# comment\nsize 3x3\n\n" + entry(2) + "\n" + entry(3) + "\n",
+			},
+			want: []BookEntry{
+				{
+					Move: models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 1, Row: 1},
+					},
+					Weight: 5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args:    args{text: ""},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			args:    args{text: "size 0x3\n"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			args:    args{text: "size 3x3\n" + encodeHash(hash) + " B bb\n"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			args:    args{text: "size 3x3\n" + encodeHash(hash) + " X bb 1\n"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			args:    args{text: "size 3x3\n" + encodeHash(hash) + " B dd 1\n"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			args:    args{text: "size 3x3\n" + entry(0) + "\n"},
+			want:    nil,
+			wantErr: true,
+		},
+	} {
+		book, err := DecodeBook(data.args.text)
+
+		var got []BookEntry
+		if err == nil {
+			got = book.Entries(storage, models.Black)
+		}
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestBookEntries(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	firstMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	secondMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 1},
+	}
+	whiteMove := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 2},
+	}
+	storage := models.NewBoard(size)
+
+	book := NewBook(size)
+	book.Add(storage, firstMove, 1)
+	book.Add(storage, secondMove, 2)
+	book.Add(storage, firstMove, 2)
+	book.Add(storage, whiteMove, 4)
+
+	got := book.Entries(storage, models.Black)
+
+	want := []BookEntry{
+		{Move: firstMove, Weight: 3},
+		{Move: secondMove, Weight: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	got = book.Entries(storage.ApplyMove(firstMove), models.Black)
+	if len(got) != 0 {
+		test.Fail()
+	}
+
+	got = book.Entries(models.NewBoard(models.Size{Width: 2, Height: 2}), 0)
+	if len(got) != 0 {
+		test.Fail()
+	}
+}
+
+func TestBookLookup(test *testing.T) {
+	type args struct {
+		storage    models.StoneStorage
+		randomizer func(n int) int
+	}
+	type data struct {
+		args     args
+		wantMove models.Move
+		wantOk   bool
+	}
+
+	size := models.Size{Width: 3, Height: 3}
+	firstMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	secondMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 1},
+	}
+	storage := models.NewBoard(size)
+
+	book := NewBook(size)
+	book.Add(storage, firstMove, 3)
+	book.Add(storage, secondMove, 1)
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage:    storage,
+				randomizer: func(n int) int { return 2 },
+			},
+			wantMove: firstMove,
+			wantOk:   true,
+		},
+		{
+			args: args{
+				storage:    storage,
+				randomizer: func(n int) int { return n - 1 },
+			},
+			wantMove: secondMove,
+			wantOk:   true,
+		},
+		{
+			args: args{
+				storage:    storage.ApplyMove(firstMove),
+				randomizer: func(n int) int { return 0 },
+			},
+			wantMove: models.Move{},
+			wantOk:   false,
+		},
+	} {
+		gotMove, gotOk :=
+			book.Lookup(data.args.storage, models.Black, data.args.randomizer)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestBookEncode(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	firstMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	secondMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 1},
+	}
+	storage := models.NewBoard(size)
+
+	book := NewBook(size)
+	book.Add(storage, firstMove, 1)
+	book.Add(storage, secondMove, 2)
+
+	got := book.Encode()
+
+	hash := encodeHash(NewZobristHasher(size).Hash(storage, models.Black))
+	want := "size 3x3\n" + hash + " B bb 2\n" + hash + " B aa 1\n"
+	if got != want {
+		test.Fail()
+	}
+
+	decodedBook, err := DecodeBook(got)
+	if decodedBook.Encode() != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func encodeHash(hash uint64) string {
+	return fmt.Sprintf("%016x", hash)
+}
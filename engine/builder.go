@@ -0,0 +1,118 @@
+package engine
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// TreeBuilder ...
+//
+// It's an analog of builders.TreeBuilder, which expands a leaf on its second
+// visit and simulates a single node per pass. Optionally, it collects
+// All-Moves-As-First statistics to the AMAF table and shares statistics
+// between transpositions via the transposition table.
+//
+type TreeBuilder struct {
+	NodeSelector  builders.NodeSelector
+	MoveGenerator simulators.MoveGenerator
+	MoveSelector  PositionalMoveSelector
+	// nil value disables collecting of All-Moves-As-First statistics
+	AMAFTable *AMAFTable
+	// nil value disables sharing of statistics between transpositions
+	TranspositionTable *TranspositionTable
+}
+
+// Pass ...
+func (builder TreeBuilder) Pass(root *tree.Node) {
+	path := []*tree.Node{root}
+	node := root
+	for len(node.Children) != 0 {
+		node = builder.NodeSelector.SelectNode(node.Children)
+		path = append(path, node)
+	}
+
+	if node == root || node.State.GameCount != 0 {
+		moves, err := builder.MoveGenerator.LegalMoves(node.Storage, node.Move)
+		if err == nil {
+			for _, move := range moves {
+				child := &tree.Node{
+					Parent:  node,
+					Move:    move,
+					Storage: node.Storage.ApplyMove(move),
+				}
+				if builder.TranspositionTable != nil {
+					child.State = builder.TranspositionTable.State(child)
+				}
+
+				node.Children = append(node.Children, child)
+			}
+
+			node = builder.NodeSelector.SelectNode(node.Children)
+			path = append(path, node)
+		}
+	}
+
+	state, rolloutMoves :=
+		rollout(node, builder.MoveGenerator, builder.MoveSelector)
+
+	var moves []models.Move
+	for _, pathNode := range path[1:] {
+		moves = append(moves, pathNode.Move)
+	}
+	moves = append(moves, rolloutMoves...)
+
+	for depth, pathNode := range path {
+		pathState := stateFor(state, node, pathNode.Move.Color)
+		if builder.TranspositionTable != nil {
+			pathNode.State =
+				builder.TranspositionTable.Update(pathNode, pathState)
+		} else {
+			pathNode.State.GameCount += pathState.GameCount
+			pathNode.State.WinCount += pathState.WinCount
+		}
+
+		if builder.AMAFTable != nil {
+			builder.updateAMAFTable(pathNode, moves[depth:], state, node)
+		}
+	}
+}
+
+// it updates statistics of moves played after the node by the color
+// of its children; the state is for the color of the leaf move
+func (builder TreeBuilder) updateAMAFTable(
+	node *tree.Node,
+	moves []models.Move,
+	state tree.NodeState,
+	leaf *tree.Node,
+) {
+	childColor := node.Move.Color.Negative()
+	childState := stateFor(state, leaf, childColor)
+	playedPoints := make(map[models.Point]bool)
+	for _, move := range moves {
+		if move.Color != childColor || playedPoints[move.Point] {
+			continue
+		}
+
+		builder.AMAFTable.Update(node, move.Point, childState)
+		playedPoints[move.Point] = true
+	}
+}
+
+// it converts the state for the color of the node move to the state
+// for the specified color
+func stateFor(
+	state tree.NodeState,
+	node *tree.Node,
+	color models.Color,
+) tree.NodeState {
+	if node.Move.Color == color {
+		return state
+	}
+
+	return tree.NodeState{
+		GameCount: state.GameCount,
+		WinCount:  state.GameCount - state.WinCount,
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type firstNodeSelector struct{}
+
+func (firstNodeSelector) SelectNode(nodes tree.NodeGroup) *tree.Node {
+	return nodes[0]
+}
+
+func TestTreeBuilderPass(test *testing.T) {
+	size := models.Size{Width: 2, Height: 1}
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(size),
+	}
+	table := NewAMAFTable(NewZobristHasher(size))
+	builder := TreeBuilder{
+		NodeSelector:  firstNodeSelector{},
+		MoveGenerator: models.MoveGenerator{},
+		MoveSelector:  firstMoveSelector{},
+		AMAFTable:     table,
+	}
+	builder.Pass(root)
+
+	if len(root.Children) != 2 {
+		test.FailNow()
+	}
+
+	child := root.Children[0]
+	wantMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	if child.Move != wantMove || child.Parent != root {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(root.State, tree.NodeState{GameCount: 1, WinCount: 1}) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(child.State, tree.NodeState{GameCount: 1}) {
+		test.Fail()
+	}
+	if len(child.Children) != 0 {
+		test.Fail()
+	}
+
+	gotRootAMAF := table.State(root, models.Point{Column: 0, Row: 0})
+	if !reflect.DeepEqual(gotRootAMAF, tree.NodeState{GameCount: 1}) {
+		test.Fail()
+	}
+
+	gotChildAMAF := table.State(child, models.Point{Column: 1, Row: 0})
+	wantChildAMAF := tree.NodeState{GameCount: 1, WinCount: 1}
+	if !reflect.DeepEqual(gotChildAMAF, wantChildAMAF) {
+		test.Fail()
+	}
+}
+
+func TestTreeBuilderPassWithTranspositions(test *testing.T) {
+	size := models.Size{Width: 2, Height: 1}
+	table := NewTranspositionTable(NewZobristHasher(size))
+	builder := TreeBuilder{
+		NodeSelector:       firstNodeSelector{},
+		MoveGenerator:      models.MoveGenerator{},
+		MoveSelector:       firstMoveSelector{},
+		TranspositionTable: table,
+	}
+
+	var roots []*tree.Node
+	for i := 0; i < 2; i++ {
+		root := &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(size),
+		}
+		builder.Pass(root)
+
+		roots = append(roots, root)
+	}
+
+	for index, root := range roots {
+		games := index + 1
+		wantRootState := tree.NodeState{GameCount: games, WinCount: games}
+		if !reflect.DeepEqual(root.State, wantRootState) {
+			test.Fail()
+		}
+
+		wantChildState := tree.NodeState{GameCount: games}
+		if !reflect.DeepEqual(root.Children[0].State, wantChildState) {
+			test.Fail()
+		}
+	}
+
+	gotTableState := table.State(roots[0])
+	if !reflect.DeepEqual(gotTableState, roots[1].State) {
+		test.Fail()
+	}
+}
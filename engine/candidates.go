@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Candidate ...
+//
+// It's a move considered by the search with its statistics.
+//
+type Candidate struct {
+	Move  models.Move
+	State tree.NodeState
+}
+
+// WinRate ...
+//
+// It returns a win rate of the move for its color, or zero if the move
+// wasn't simulated.
+//
+func (candidate Candidate) WinRate() float64 {
+	return winRate(candidate.State)
+}
+
+// RankCandidates ...
+//
+// It returns children of the root ordered by their game counts (i.e.
+// by the search confidence) and then by their win rates.
+//
+func RankCandidates(root *tree.Node) []Candidate {
+	var candidates []Candidate
+	for _, child := range root.Children {
+		candidates = append(candidates, Candidate{
+			Move:  child.Move,
+			State: child.State,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i int, j int) bool {
+		if candidates[i].State.GameCount != candidates[j].State.GameCount {
+			return candidates[i].State.GameCount > candidates[j].State.GameCount
+		}
+
+		return candidates[i].WinRate() > candidates[j].WinRate()
+	})
+
+	return candidates
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestCandidateWinRate(test *testing.T) {
+	type fields struct {
+		state tree.NodeState
+	}
+	type data struct {
+		fields fields
+		want   float64
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{tree.NodeState{}},
+			want:   0,
+		},
+		{
+			fields: fields{tree.NodeState{GameCount: 4, WinCount: 3}},
+			want:   0.75,
+		},
+	} {
+		candidate := Candidate{State: data.fields.state}
+		got := candidate.WinRate()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRankCandidates(test *testing.T) {
+	type args struct {
+		root *tree.Node
+	}
+	type data struct {
+		args args
+		want []Candidate
+	}
+
+	moves := []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+	}
+	for _, data := range []data{
+		{
+			args: args{&tree.Node{}},
+			want: nil,
+		},
+		{
+			args: args{
+				root: &tree.Node{
+					Children: tree.NodeGroup{
+						{
+							Move:  moves[0],
+							State: tree.NodeState{GameCount: 2, WinCount: 2},
+						},
+						{
+							Move:  moves[1],
+							State: tree.NodeState{GameCount: 5, WinCount: 1},
+						},
+						{
+							Move:  moves[2],
+							State: tree.NodeState{GameCount: 5, WinCount: 3},
+						},
+					},
+				},
+			},
+			want: []Candidate{
+				{
+					Move:  moves[2],
+					State: tree.NodeState{GameCount: 5, WinCount: 3},
+				},
+				{
+					Move:  moves[1],
+					State: tree.NodeState{GameCount: 5, WinCount: 1},
+				},
+				{
+					Move:  moves[0],
+					State: tree.NodeState{GameCount: 2, WinCount: 2},
+				},
+			},
+		},
+	} {
+		got := RankCandidates(data.args.root)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
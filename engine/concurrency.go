@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Concurrency ...
+//
+// It describes concurrency of parallel search components. Zero values mean
+// automatic ones: the CPU count for the threads and their even distribution
+// between the components otherwise. If both components are parallel
+// and automatic, each one gets about the square root of the threads.
+//
+type Concurrency struct {
+	// it's a cap for the total count of threads used by the search
+	Threads   int
+	Simulator int
+	Builder   int
+}
+
+// ResolveConcurrency ...
+//
+// It replaces the automatic values of the requested concurrency by actual
+// ones and validates the result. Concurrency of disabled components
+// is resolved to 1. Note that each builder thread runs its own simulator,
+// so their concurrencies are multiplied.
+//
+func ResolveConcurrency(
+	requested Concurrency,
+	parallelSimulator bool,
+	parallelBuilder bool,
+	cpuCount int,
+) (Concurrency, error) {
+	if requested.Threads < 0 || requested.Simulator < 0 || requested.Builder < 0 {
+		return Concurrency{}, errors.New("negative concurrency")
+	}
+	if requested.Simulator != 0 && !parallelSimulator {
+		return Concurrency{}, errors.New(
+			"simulator concurrency requires the parallel simulator",
+		)
+	}
+	if requested.Builder != 0 && !parallelBuilder {
+		return Concurrency{}, errors.New(
+			"builder concurrency requires the parallel builder",
+		)
+	}
+
+	resolved := requested
+	if resolved.Threads == 0 {
+		resolved.Threads = cpuCount
+	}
+	if !parallelSimulator {
+		resolved.Simulator = 1
+	}
+	if !parallelBuilder {
+		resolved.Builder = 1
+	}
+
+	switch {
+	case resolved.Simulator == 0 && resolved.Builder == 0:
+		// concurrencies are multiplied, so the even distribution is
+		// by the square root; the builder gets the rest of the threads,
+		// because it's a top-level component
+		resolved.Simulator = int(math.Sqrt(float64(resolved.Threads)))
+		resolved.Simulator = max(resolved.Simulator, 1)
+		resolved.Builder = max(resolved.Threads/resolved.Simulator, 1)
+	case resolved.Simulator == 0:
+		resolved.Simulator = max(resolved.Threads/resolved.Builder, 1)
+	case resolved.Builder == 0:
+		resolved.Builder = max(resolved.Threads/resolved.Simulator, 1)
+	}
+
+	if resolved.Simulator*resolved.Builder > resolved.Threads {
+		return Concurrency{}, fmt.Errorf(
+			"%d simulator threads by %d builder threads exceed the cap of %d",
+			resolved.Simulator,
+			resolved.Builder,
+			resolved.Threads,
+		)
+	}
+
+	return resolved, nil
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveConcurrency(test *testing.T) {
+	type args struct {
+		requested         Concurrency
+		parallelSimulator bool
+		parallelBuilder   bool
+		cpuCount          int
+	}
+	type data struct {
+		args    args
+		want    Concurrency
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				requested:         Concurrency{},
+				parallelSimulator: false,
+				parallelBuilder:   false,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 8, Simulator: 1, Builder: 1},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{},
+				parallelSimulator: false,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 8, Simulator: 1, Builder: 8},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Threads: 4},
+				parallelSimulator: true,
+				parallelBuilder:   false,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 4, Simulator: 4, Builder: 1},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Threads: 4},
+				parallelSimulator: true,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 4, Simulator: 2, Builder: 2},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Builder: 2},
+				parallelSimulator: true,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 8, Simulator: 4, Builder: 2},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Threads: 6, Simulator: 4},
+				parallelSimulator: true,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{Threads: 6, Simulator: 4, Builder: 1},
+			wantErr: false,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Threads: -1},
+				parallelSimulator: false,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Simulator: 2},
+				parallelSimulator: false,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Builder: 2},
+				parallelSimulator: false,
+				parallelBuilder:   false,
+				cpuCount:          8,
+			},
+			want:    Concurrency{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Threads: 2, Builder: 4},
+				parallelSimulator: false,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				requested:         Concurrency{Simulator: 4, Builder: 4},
+				parallelSimulator: true,
+				parallelBuilder:   true,
+				cpuCount:          8,
+			},
+			want:    Concurrency{},
+			wantErr: true,
+		},
+	} {
+		got, gotErr := ResolveConcurrency(
+			data.args.requested,
+			data.args.parallelSimulator,
+			data.args.parallelBuilder,
+			data.args.cpuCount,
+		)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
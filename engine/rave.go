@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"math"
+	"sync"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// AMAFTable ...
+//
+// It stores All-Moves-As-First statistics: for each node, results of games
+// passed through it, grouped by points of moves played after it by the color
+// of its children. Nodes are identified by their positions and colors
+// to move, so the statistics are shared between copies of a tree (e.g.
+// by the parallel builder). It's safe for concurrent use.
+//
+type AMAFTable struct {
+	hasher ZobristHasher
+	mutex  sync.RWMutex
+	states map[uint64]map[models.Point]tree.NodeState
+}
+
+// NewAMAFTable ...
+func NewAMAFTable(hasher ZobristHasher) *AMAFTable {
+	return &AMAFTable{
+		hasher: hasher,
+		states: make(map[uint64]map[models.Point]tree.NodeState),
+	}
+}
+
+// State ...
+//
+// It returns the statistics of a move at the point after the node.
+//
+func (table *AMAFTable) State(
+	node *tree.Node,
+	point models.Point,
+) tree.NodeState {
+	key := table.key(node)
+
+	table.mutex.RLock()
+	defer table.mutex.RUnlock()
+
+	return table.states[key][point]
+}
+
+// Update ...
+//
+// It adds the state to the statistics of a move at the point after the node.
+//
+func (table *AMAFTable) Update(
+	node *tree.Node,
+	point models.Point,
+	state tree.NodeState,
+) {
+	key := table.key(node)
+
+	table.mutex.Lock()
+	defer table.mutex.Unlock()
+
+	if table.states[key] == nil {
+		table.states[key] = make(map[models.Point]tree.NodeState)
+	}
+
+	currentState := table.states[key][point]
+	currentState.GameCount += state.GameCount
+	currentState.WinCount += state.WinCount
+	table.states[key][point] = currentState
+}
+
+// node states are for colors of node moves, so the color to move
+// is the opposite one
+func (table *AMAFTable) key(node *tree.Node) uint64 {
+	return table.hasher.Hash(node.Storage, node.Move.Color.Negative())
+}
+
+// RAVEScorer ...
+//
+// It scores a node by Rapid Action Value Estimation: its win rate is mixed
+// with its All-Moves-As-First win rate by the weight
+// sqrt(Equivalence / (3 * games + Equivalence)), so the latter dominates
+// in rarely visited nodes. Factor scales the UCB exploration term.
+//
+type RAVEScorer struct {
+	Table       *AMAFTable
+	Factor      float64
+	Equivalence float64
+}
+
+// ScoreNode ...
+func (scorer RAVEScorer) ScoreNode(node *tree.Node) float64 {
+	gameCount := float64(node.State.GameCount)
+	if gameCount == 0 {
+		return math.Inf(+1)
+	}
+
+	var amafState tree.NodeState
+	parentGameCount := gameCount
+	if node.Parent != nil {
+		amafState = scorer.Table.State(node.Parent, node.Move.Point)
+		if node.Parent.State.GameCount != 0 {
+			parentGameCount = float64(node.Parent.State.GameCount)
+		}
+	}
+
+	weight := math.Sqrt(
+		scorer.Equivalence / (3*gameCount + scorer.Equivalence),
+	)
+	value := (1-weight)*winRate(node.State) + weight*winRate(amafState)
+	exploration := math.Sqrt(math.Log(parentGameCount) / gameCount)
+	return value + scorer.Factor*exploration
+}
@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestAMAFTable(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	node := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(size),
+	}
+	point := models.Point{Column: 1, Row: 2}
+
+	table := NewAMAFTable(NewZobristHasher(size))
+	table.Update(node, point, tree.NodeState{GameCount: 1, WinCount: 1})
+	table.Update(node, point, tree.NodeState{GameCount: 2, WinCount: 0})
+
+	got := table.State(node, point)
+	if !reflect.DeepEqual(got, tree.NodeState{GameCount: 3, WinCount: 1}) {
+		test.Fail()
+	}
+
+	got = table.State(node, models.Point{Column: 2, Row: 1})
+	if !reflect.DeepEqual(got, tree.NodeState{}) {
+		test.Fail()
+	}
+
+	// a copy of the node in another tree
+	got = table.State(&tree.Node{Move: node.Move, Storage: node.Storage}, point)
+	if !reflect.DeepEqual(got, tree.NodeState{GameCount: 3, WinCount: 1}) {
+		test.Fail()
+	}
+
+	got = table.State(
+		&tree.Node{
+			Move:    models.NewPreliminaryMove(models.White),
+			Storage: node.Storage,
+		},
+		point,
+	)
+	if !reflect.DeepEqual(got, tree.NodeState{}) {
+		test.Fail()
+	}
+}
+
+func TestRAVEScorerScoreNode(test *testing.T) {
+	type fields struct {
+		factor      float64
+		equivalence float64
+	}
+	type args struct {
+		node *tree.Node
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   float64
+	}
+
+	size := models.Size{Width: 3, Height: 3}
+	point := models.Point{Column: 1, Row: 1}
+	parent := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(size),
+		State:   tree.NodeState{GameCount: 100},
+	}
+	table := NewAMAFTable(NewZobristHasher(size))
+	table.Update(parent, point, tree.NodeState{GameCount: 10, WinCount: 10})
+
+	for _, data := range []data{
+		{
+			fields: fields{factor: 1, equivalence: 30},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					Move:   models.Move{Point: point},
+				},
+			},
+			want: math.Inf(+1),
+		},
+		{
+			fields: fields{factor: 0, equivalence: 0},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					Move:   models.Move{Point: point},
+					State:  tree.NodeState{GameCount: 10, WinCount: 5},
+				},
+			},
+			want: 0.5,
+		},
+		{
+			fields: fields{factor: 0, equivalence: 30},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					Move:   models.Move{Point: point},
+					State:  tree.NodeState{GameCount: 10, WinCount: 5},
+				},
+			},
+			want: 0.5*(1-math.Sqrt(0.5)) + math.Sqrt(0.5),
+		},
+		{
+			fields: fields{factor: 2, equivalence: 0},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					Move:   models.Move{Point: point},
+					State:  tree.NodeState{GameCount: 10, WinCount: 5},
+				},
+			},
+			want: 0.5 + 2*math.Sqrt(math.Log(100)/10),
+		},
+	} {
+		scorer := RAVEScorer{
+			Table:       table,
+			Factor:      data.fields.factor,
+			Equivalence: data.fields.equivalence,
+		}
+		got := scorer.ScoreNode(data.args.node)
+
+		if got != data.want && math.Abs(got-data.want) > 1e-9 {
+			test.Fail()
+		}
+	}
+}
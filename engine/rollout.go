@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"math/rand"
+
+	climodels "github.com/thewizardplusplus/go-atari-cli/models"
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// PositionalMoveSelector ...
+//
+// Unlike simulators.MoveSelector, it selects a move knowing the position.
+//
+type PositionalMoveSelector interface {
+	SelectMove(storage models.StoneStorage, moves []models.Move) models.Move
+}
+
+// HeuristicMoveSelector ...
+//
+// It selects a random move among the best ones by the following priority:
+// immediate captures, escapes from atari, and moves that aren't self-atari.
+// If all the moves are self-atari, it selects any of them.
+//
+type HeuristicMoveSelector struct{}
+
+// SelectMove ...
+//
+// The moves should be legal and of the same color.
+//
+func (selector HeuristicMoveSelector) SelectMove(
+	storage models.StoneStorage,
+	moves []models.Move,
+) models.Move {
+	escapePoints := make(map[models.Point]bool)
+	for _, group := range climodels.FindAtariGroups(storage) {
+		if group.Color == moves[0].Color {
+			escapePoints[group.Liberties[0]] = true
+		}
+	}
+
+	var captures, escapes, safeMoves []models.Move
+	for _, move := range moves {
+		nextStorage := storage.ApplyMove(move)
+		if nextStorage.HasCapture(move.Color) {
+			captures = append(captures, move)
+			continue
+		}
+
+		group, _ := climodels.FindGroup(nextStorage, move.Point)
+		if group.InAtari() {
+			continue
+		}
+
+		if escapePoints[move.Point] {
+			escapes = append(escapes, move)
+		}
+		safeMoves = append(safeMoves, move)
+	}
+
+	for _, preferredMoves := range [][]models.Move{captures, escapes, safeMoves} {
+		if len(preferredMoves) != 0 {
+			return preferredMoves[rand.Intn(len(preferredMoves))]
+		}
+	}
+
+	return moves[rand.Intn(len(moves))]
+}
+
+// MoveSelectorAdapter ...
+//
+// It adapts simulators.MoveSelector to PositionalMoveSelector.
+//
+type MoveSelectorAdapter struct {
+	MoveSelector simulators.MoveSelector
+}
+
+// SelectMove ...
+func (adapter MoveSelectorAdapter) SelectMove(
+	storage models.StoneStorage,
+	moves []models.Move,
+) models.Move {
+	return adapter.MoveSelector.SelectMove(moves)
+}
+
+// RolloutSimulator ...
+//
+// It's an analog of simulators.RolloutSimulator, which uses a positional
+// move selector.
+//
+type RolloutSimulator struct {
+	MoveGenerator simulators.MoveGenerator
+	MoveSelector  PositionalMoveSelector
+}
+
+// Simulate ...
+//
+// It plays a game from the node to the end and returns its result
+// for the color of the node move.
+//
+func (simulator RolloutSimulator) Simulate(root *tree.Node) tree.NodeState {
+	state, _ := rollout(root, simulator.MoveGenerator, simulator.MoveSelector)
+	return state
+}
+
+// it plays a game from the node to the end and returns its result
+// for the color of the node move and the played moves
+func rollout(
+	root *tree.Node,
+	generator simulators.MoveGenerator,
+	selector PositionalMoveSelector,
+) (state tree.NodeState, moves []models.Move) {
+	storage, previousMove := root.Storage, root.Move
+	for {
+		legalMoves, err := generator.LegalMoves(storage, previousMove)
+		if err != nil {
+			// the error is for the color to move
+			hasMoverWon := err == models.ErrAlreadyWin
+			isMoverRoot := previousMove.Color.Negative() == root.Move.Color
+
+			state = tree.NodeState{GameCount: 1}
+			if hasMoverWon == isMoverRoot {
+				state.WinCount = 1
+			}
+
+			return state, moves
+		}
+
+		move := selector.SelectMove(storage, legalMoves)
+		storage, previousMove = storage.ApplyMove(move), move
+		moves = append(moves, move)
+	}
+}
@@ -0,0 +1,178 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type firstMoveSelector struct{}
+
+func (firstMoveSelector) SelectMove(
+	storage models.StoneStorage,
+	moves []models.Move,
+) models.Move {
+	return moves[0]
+}
+
+func TestHeuristicMoveSelectorSelectMove(test *testing.T) {
+	type args struct {
+		storage models.StoneStorage
+		moves   []models.Move
+	}
+	type data struct {
+		args args
+		want models.Move
+	}
+
+	makeBoard := func(moves []models.Move) models.StoneStorage {
+		board := models.NewBoard(models.Size{Width: 3, Height: 3})
+		for _, move := range moves {
+			board = board.ApplyMove(move)
+		}
+
+		return board
+	}
+	makeMoves := func(points []models.Point) []models.Move {
+		var moves []models.Move
+		for _, point := range points {
+			moves = append(moves, models.Move{Color: models.Black, Point: point})
+		}
+
+		return moves
+	}
+
+	for _, data := range []data{
+		{
+			// a capture
+			args: args{
+				storage: makeBoard([]models.Move{
+					{Color: models.White, Point: models.Point{Column: 0, Row: 0}},
+					{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+				}),
+				moves: makeMoves([]models.Point{
+					{Column: 2, Row: 0},
+					{Column: 0, Row: 1},
+					{Column: 1, Row: 1},
+					{Column: 2, Row: 2},
+				}),
+			},
+			want: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 0, Row: 1},
+			},
+		},
+		{
+			// an escape from atari
+			args: args{
+				storage: makeBoard([]models.Move{
+					{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+					{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+				}),
+				moves: makeMoves([]models.Point{
+					{Column: 2, Row: 0},
+					{Column: 0, Row: 1},
+					{Column: 2, Row: 2},
+				}),
+			},
+			want: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 0, Row: 1},
+			},
+		},
+		{
+			// avoiding self-atari
+			args: args{
+				storage: makeBoard([]models.Move{
+					{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+					{Color: models.White, Point: models.Point{Column: 0, Row: 2}},
+					{Color: models.White, Point: models.Point{Column: 2, Row: 2}},
+				}),
+				moves: makeMoves([]models.Point{
+					{Column: 0, Row: 0},
+					{Column: 1, Row: 1},
+					{Column: 1, Row: 2},
+				}),
+			},
+			want: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 1, Row: 1},
+			},
+		},
+		{
+			// only self-atari
+			args: args{
+				storage: makeBoard([]models.Move{
+					{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+				}),
+				moves: makeMoves([]models.Point{
+					{Column: 0, Row: 0},
+				}),
+			},
+			want: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 0, Row: 0},
+			},
+		},
+	} {
+		got := HeuristicMoveSelector{}.SelectMove(data.args.storage, data.args.moves)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRolloutSimulatorSimulate(test *testing.T) {
+	type args struct {
+		root *tree.Node
+	}
+	type data struct {
+		args args
+		want tree.NodeState
+	}
+
+	blackMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	whiteMove := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 1, Row: 0},
+	}
+	board := models.NewBoard(models.Size{Width: 2, Height: 1})
+	board = board.ApplyMove(blackMove)
+
+	for _, data := range []data{
+		{
+			args: args{
+				root: &tree.Node{
+					Move:    blackMove,
+					Storage: board,
+				},
+			},
+			want: tree.NodeState{GameCount: 1, WinCount: 0},
+		},
+		{
+			args: args{
+				root: &tree.Node{
+					Move:    whiteMove,
+					Storage: board.ApplyMove(whiteMove),
+				},
+			},
+			want: tree.NodeState{GameCount: 1, WinCount: 1},
+		},
+	} {
+		simulator := RolloutSimulator{
+			MoveGenerator: models.MoveGenerator{},
+			MoveSelector:  firstMoveSelector{},
+		}
+		got := simulator.Simulate(data.args.root)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
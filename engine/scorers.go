@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// WinRateScorer ...
+//
+// It scores a node by its win rate only, so it's suitable for the final
+// selection of a move, but not for building a tree.
+//
+type WinRateScorer struct{}
+
+// ScoreNode ...
+func (scorer WinRateScorer) ScoreNode(node *tree.Node) float64 {
+	return winRate(node.State)
+}
+
+// GameCountScorer ...
+//
+// It scores a node by its game count only, so it's suitable for the final
+// selection of a move, if the building is stopped by game counts
+// (see AdaptiveBuilder), but not for building a tree.
+//
+type GameCountScorer struct{}
+
+// ScoreNode ...
+func (scorer GameCountScorer) ScoreNode(node *tree.Node) float64 {
+	return float64(node.State.GameCount)
+}
+
+// UCB1TunedScorer ...
+//
+// It scores a node by the UCB1-tuned formula, which bounds the exploration
+// term by a variance estimation of the node results. Factor scales
+// the exploration term; the original formula corresponds to 1.
+//
+type UCB1TunedScorer struct {
+	Factor float64
+}
+
+// ScoreNode ...
+func (scorer UCB1TunedScorer) ScoreNode(node *tree.Node) float64 {
+	gameCount := float64(node.State.GameCount)
+	if gameCount == 0 {
+		return math.Inf(+1)
+	}
+
+	parentGameCount := gameCount
+	if node.Parent != nil && node.Parent.State.GameCount != 0 {
+		parentGameCount = float64(node.Parent.State.GameCount)
+	}
+
+	// results are binary, so the variance is expressed by the win rate
+	rate := winRate(node.State)
+	logarithm := math.Log(parentGameCount)
+	variance := rate*(1-rate) + math.Sqrt(2*logarithm/gameCount)
+	exploration := math.Sqrt(logarithm / gameCount * math.Min(0.25, variance))
+	return rate + scorer.Factor*exploration
+}
+
+func winRate(state tree.NodeState) float64 {
+	if state.GameCount == 0 {
+		return 0
+	}
+
+	return float64(state.WinCount) / float64(state.GameCount)
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestWinRateScorerScoreNode(test *testing.T) {
+	type args struct {
+		node *tree.Node
+	}
+	type data struct {
+		args args
+		want float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{&tree.Node{}},
+			want: 0,
+		},
+		{
+			args: args{
+				node: &tree.Node{
+					State: tree.NodeState{GameCount: 4, WinCount: 1},
+				},
+			},
+			want: 0.25,
+		},
+	} {
+		got := WinRateScorer{}.ScoreNode(data.args.node)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestGameCountScorerScoreNode(test *testing.T) {
+	node := &tree.Node{State: tree.NodeState{GameCount: 4, WinCount: 1}}
+	got := GameCountScorer{}.ScoreNode(node)
+
+	if got != 4 {
+		test.Fail()
+	}
+}
+
+func TestUCB1TunedScorerScoreNode(test *testing.T) {
+	type fields struct {
+		factor float64
+	}
+	type args struct {
+		node *tree.Node
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   float64
+	}
+
+	parent := &tree.Node{State: tree.NodeState{GameCount: 100}}
+	for _, data := range []data{
+		{
+			fields: fields{1},
+			args: args{
+				node: &tree.Node{Parent: parent},
+			},
+			want: math.Inf(+1),
+		},
+		{
+			fields: fields{0},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					State:  tree.NodeState{GameCount: 10, WinCount: 5},
+				},
+			},
+			want: 0.5,
+		},
+		{
+			// the variance bound is reached
+			fields: fields{1},
+			args: args{
+				node: &tree.Node{
+					Parent: parent,
+					State:  tree.NodeState{GameCount: 10, WinCount: 5},
+				},
+			},
+			want: 0.5 + math.Sqrt(math.Log(100)/10*0.25),
+		},
+		{
+			// the variance isn't bounded
+			fields: fields{2},
+			args: args{
+				node: &tree.Node{
+					Parent: &tree.Node{State: tree.NodeState{GameCount: 2}},
+					State:  tree.NodeState{GameCount: 1000, WinCount: 1000},
+				},
+			},
+			want: 1 + 2*math.Sqrt(
+				math.Log(2)/1000*math.Sqrt(2*math.Log(2)/1000),
+			),
+		},
+	} {
+		scorer := UCB1TunedScorer{Factor: data.fields.factor}
+		got := scorer.ScoreNode(data.args.node)
+
+		if got != data.want && math.Abs(got-data.want) > 1e-9 {
+			test.Fail()
+		}
+	}
+}
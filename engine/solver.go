@@ -0,0 +1,184 @@
+package engine
+
+import (
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators"
+)
+
+// Proof ...
+//
+// It's a result of the exact solving of a position for the color to move.
+//
+type Proof int
+
+// ...
+const (
+	Unproven Proof = iota
+	ProvenWin
+	ProvenLoss
+)
+
+// String ...
+func (proof Proof) String() string {
+	switch proof {
+	case ProvenWin:
+		return "proven win"
+	case ProvenLoss:
+		return "proven loss"
+	default:
+		return "unproven"
+	}
+}
+
+// Solver ...
+//
+// It solves positions exactly by the alpha-beta search in the negamax form
+// (as results are only wins and losses, it stops on the first winning move).
+// Moves with captures are tried first, and results are cached
+// by the Zobrist hashing.
+//
+type Solver struct {
+	MoveGenerator simulators.MoveGenerator
+	// the search gives up as unproven after visiting this count of positions
+	MaximalNodeCount int
+	// the search gives up as unproven after this time point;
+	// zero value disables the limit
+	Deadline time.Time
+	// it's required only if the deadline is set
+	Clock func() time.Time
+}
+
+type solving struct {
+	generator simulators.MoveGenerator
+	hasher    ZobristHasher
+	cache     map[uint64]Proof
+	nodeCount int
+	maximal   int
+	deadline  time.Time
+	clock     func() time.Time
+}
+
+// Solve ...
+//
+// It returns the best move for the color and its proof. For a proven loss,
+// it returns any legal move; for an unproven position, it returns
+// a zero move. It returns the move generator error, if the game is already
+// over.
+//
+func (solver Solver) Solve(
+	storage models.StoneStorage,
+	color models.Color,
+) (models.Move, Proof, error) {
+	previousMove := models.NewPreliminaryMove(color)
+	moves, err := solver.MoveGenerator.LegalMoves(storage, previousMove)
+	if err != nil {
+		return models.Move{}, Unproven, err // don't wrap
+	}
+
+	state := &solving{
+		generator: solver.MoveGenerator,
+		hasher:    NewZobristHasher(storage.Size()),
+		cache:     make(map[uint64]Proof),
+		maximal:   solver.MaximalNodeCount,
+		deadline:  solver.Deadline,
+		clock:     solver.Clock,
+	}
+	proof := ProvenLoss
+	for _, child := range orderChildren(storage, moves) {
+		switch state.solve(child.storage, child.move) {
+		case ProvenLoss:
+			return child.move, ProvenWin, nil
+		case Unproven:
+			proof = Unproven
+		}
+	}
+	if proof == Unproven {
+		return models.Move{}, Unproven, nil
+	}
+
+	return moves[0], ProvenLoss, nil
+}
+
+// it returns the proof for the color to move after the previous move
+func (state *solving) solve(
+	storage models.StoneStorage,
+	previousMove models.Move,
+) Proof {
+	moves, err := state.generator.LegalMoves(storage, previousMove)
+	switch err {
+	case nil:
+	case models.ErrAlreadyWin:
+		return ProvenWin
+	default:
+		return ProvenLoss
+	}
+
+	key := state.hasher.Hash(storage, previousMove.Color.Negative())
+	if proof, ok := state.cache[key]; ok {
+		return proof
+	}
+
+	state.nodeCount++
+	if state.nodeCount > state.maximal || state.isExpired() {
+		return Unproven
+	}
+
+	proof := ProvenLoss
+	for _, child := range orderChildren(storage, moves) {
+		switch state.solve(child.storage, child.move) {
+		case ProvenLoss:
+			state.cache[key] = ProvenWin
+			return ProvenWin
+		case Unproven:
+			proof = Unproven
+		}
+	}
+	if proof != Unproven {
+		state.cache[key] = proof
+	}
+
+	return proof
+}
+
+func (state *solving) isExpired() bool {
+	return !state.deadline.IsZero() && !state.clock().Before(state.deadline)
+}
+
+// EmptyPointCount ...
+func EmptyPointCount(storage models.StoneStorage) int {
+	var count int
+	for _, point := range storage.Size().Points() {
+		if _, ok := storage.Stone(point); !ok {
+			count++
+		}
+	}
+
+	return count
+}
+
+type solvingChild struct {
+	move    models.Move
+	storage models.StoneStorage
+}
+
+// it returns positions after the moves, which are computed once
+// for ordering and solving; moves with captures are moved to the beginning
+// keeping the order otherwise
+func orderChildren(
+	storage models.StoneStorage,
+	moves []models.Move,
+) []solvingChild {
+	var captures, others []solvingChild
+	for _, move := range moves {
+		child := solvingChild{move: move, storage: storage.ApplyMove(move)}
+		if child.storage.HasCapture(move.Color) {
+			captures = append(captures, child)
+		} else {
+			others = append(others, child)
+		}
+	}
+
+	return append(captures, others...)
+}
@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestProofString(test *testing.T) {
+	type data struct {
+		proof Proof
+		want  string
+	}
+
+	for _, data := range []data{
+		{proof: Unproven, want: "unproven"},
+		{proof: ProvenWin, want: "proven win"},
+		{proof: ProvenLoss, want: "proven loss"},
+	} {
+		got := data.proof.String()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestSolverSolve(test *testing.T) {
+	type fields struct {
+		maximalNodeCount int
+		deadline         time.Time
+	}
+	type args struct {
+		storage models.StoneStorage
+	}
+	type data struct {
+		fields    fields
+		args      args
+		wantMove  models.Move
+		wantProof Proof
+		wantErr   error
+	}
+
+	now := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	size := models.Size{Width: 2, Height: 1}
+	whiteStone := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	for _, data := range []data{
+		{
+			fields: fields{1000, time.Time{}},
+			args: args{
+				storage: models.NewBoard(size).ApplyMove(whiteStone),
+			},
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 1, Row: 0},
+			},
+			wantProof: ProvenWin,
+			wantErr:   nil,
+		},
+		{
+			fields: fields{1000, time.Time{}},
+			args: args{
+				storage: models.NewBoard(size),
+			},
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 0, Row: 0},
+			},
+			wantProof: ProvenLoss,
+			wantErr:   nil,
+		},
+		{
+			fields: fields{0, time.Time{}},
+			args: args{
+				storage: models.NewBoard(size),
+			},
+			wantMove:  models.Move{},
+			wantProof: Unproven,
+			wantErr:   nil,
+		},
+		{
+			fields: fields{1000, now},
+			args: args{
+				storage: models.NewBoard(size),
+			},
+			wantMove:  models.Move{},
+			wantProof: Unproven,
+			wantErr:   nil,
+		},
+		{
+			fields: fields{1000, time.Time{}},
+			args: args{
+				storage: models.NewBoard(models.Size{Width: 3, Height: 1}).
+					ApplyMove(whiteStone).
+					ApplyMove(models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 1, Row: 0},
+					}),
+			},
+			wantMove:  models.Move{},
+			wantProof: Unproven,
+			wantErr:   models.ErrAlreadyWin,
+		},
+	} {
+		solver := Solver{
+			MoveGenerator:    models.MoveGenerator{},
+			MaximalNodeCount: data.fields.maximalNodeCount,
+			Deadline:         data.fields.deadline,
+			Clock:            clock,
+		}
+		gotMove, gotProof, gotErr :=
+			solver.Solve(data.args.storage, models.Black)
+
+		if gotMove != data.wantMove {
+			test.Fail()
+		}
+		if gotProof != data.wantProof {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEmptyPointCount(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 2})
+	board = board.ApplyMove(models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 1},
+	})
+
+	got := EmptyPointCount(board)
+
+	if got != 5 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,172 @@
+package engine
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Symmetry ...
+//
+// It's a rotation or a reflection of a board. Any of them is a composition
+// of a transposition (only for square boards) and flips of columns
+// and rows, so there are 8 symmetries of square boards and 4 ones of others.
+//
+type Symmetry struct {
+	Transpose   bool
+	FlipColumns bool
+	FlipRows    bool
+}
+
+// Apply ...
+func (symmetry Symmetry) Apply(
+	size models.Size,
+	point models.Point,
+) models.Point {
+	if symmetry.Transpose {
+		point.Column, point.Row = point.Row, point.Column
+	}
+	if symmetry.FlipColumns {
+		point.Column = size.Width - 1 - point.Column
+	}
+	if symmetry.FlipRows {
+		point.Row = size.Height - 1 - point.Row
+	}
+
+	return point
+}
+
+// Symmetries ...
+//
+// It returns symmetries that leave the storage unchanged. The identity one
+// is always the first.
+//
+func Symmetries(storage models.StoneStorage) []Symmetry {
+	size := storage.Size()
+
+	var symmetries []Symmetry
+	for _, transpose := range []bool{false, true} {
+		if transpose && size.Width != size.Height {
+			continue
+		}
+
+		for _, flipColumns := range []bool{false, true} {
+			for _, flipRows := range []bool{false, true} {
+				symmetry := Symmetry{
+					Transpose:   transpose,
+					FlipColumns: flipColumns,
+					FlipRows:    flipRows,
+				}
+				if preserves(storage, symmetry) {
+					symmetries = append(symmetries, symmetry)
+				}
+			}
+		}
+	}
+
+	return symmetries
+}
+
+// UniqueMoves ...
+//
+// It keeps only the first move of each class of moves equivalent under
+// the symmetries of the storage. The order of the moves is preserved.
+//
+func UniqueMoves(
+	storage models.StoneStorage,
+	moves []models.Move,
+) []models.Move {
+	symmetries := Symmetries(storage)
+	if len(symmetries) == 1 {
+		return moves
+	}
+
+	size := storage.Size()
+	coveredMoves := make(map[models.Move]bool)
+	var uniqueMoves []models.Move
+	for _, move := range moves {
+		if coveredMoves[move] {
+			continue
+		}
+
+		for _, symmetry := range symmetries {
+			equivalentMove := models.Move{
+				Color: move.Color,
+				Point: symmetry.Apply(size, move.Point),
+			}
+			coveredMoves[equivalentMove] = true
+		}
+
+		uniqueMoves = append(uniqueMoves, move)
+	}
+
+	return uniqueMoves
+}
+
+// SymmetricMoveGenerator ...
+//
+// It's a wrapper of a move generator, which keeps only unique moves
+// (see UniqueMoves()) in the root position. The root is recognized
+// by its position and color to move, so the pruning is applied
+// on expanding of any copy of the root (e.g. by the parallel builder).
+//
+type SymmetricMoveGenerator struct {
+	generator     simulators.MoveGenerator
+	hasher        ZobristHasher
+	rootHash      uint64
+	rootMoveCount int
+}
+
+// NewSymmetricMoveGenerator ...
+func NewSymmetricMoveGenerator(
+	generator simulators.MoveGenerator,
+	root *tree.Node,
+) SymmetricMoveGenerator {
+	hasher := NewZobristHasher(root.Storage.Size())
+	// the game end is handled by the searcher
+	rootMoveCount := -1
+	if moves, err := generator.LegalMoves(root.Storage, root.Move); err == nil {
+		rootMoveCount = len(moves)
+	}
+
+	return SymmetricMoveGenerator{
+		generator:     generator,
+		hasher:        hasher,
+		rootHash:      hasher.Hash(root.Storage, root.Move.Color.Negative()),
+		rootMoveCount: rootMoveCount,
+	}
+}
+
+// LegalMoves ...
+func (generator SymmetricMoveGenerator) LegalMoves(
+	storage models.StoneStorage,
+	previousMove models.Move,
+) ([]models.Move, error) {
+	moves, err := generator.generator.LegalMoves(storage, previousMove)
+	if err != nil {
+		return nil, err // don't wrap
+	}
+
+	// the move count is checked first, because it's much cheaper
+	// than the hashing, and the generator is called on each rollout step
+	if len(moves) != generator.rootMoveCount ||
+		generator.hasher.Hash(storage, previousMove.Color.Negative()) !=
+			generator.rootHash {
+		return moves, nil
+	}
+
+	return UniqueMoves(storage, moves), nil
+}
+
+func preserves(storage models.StoneStorage, symmetry Symmetry) bool {
+	size := storage.Size()
+	for _, point := range size.Points() {
+		color, ok := storage.Stone(point)
+		otherColor, otherOk := storage.Stone(symmetry.Apply(size, point))
+		if ok != otherOk || (ok && color != otherColor) {
+			return false
+		}
+	}
+
+	return true
+}
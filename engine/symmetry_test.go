@@ -0,0 +1,312 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestSymmetryApply(test *testing.T) {
+	type args struct {
+		size  models.Size
+		point models.Point
+	}
+	type data struct {
+		symmetry Symmetry
+		args     args
+		want     models.Point
+	}
+
+	size := models.Size{Width: 3, Height: 2}
+	square := models.Size{Width: 3, Height: 3}
+	for _, data := range []data{
+		{
+			symmetry: Symmetry{},
+			args:     args{size, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 0, Row: 1},
+		},
+		{
+			symmetry: Symmetry{FlipColumns: true},
+			args:     args{size, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 2, Row: 1},
+		},
+		{
+			symmetry: Symmetry{FlipRows: true},
+			args:     args{size, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 0, Row: 0},
+		},
+		{
+			symmetry: Symmetry{Transpose: true},
+			args:     args{square, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 1, Row: 0},
+		},
+		{
+			symmetry: Symmetry{Transpose: true, FlipColumns: true},
+			args:     args{square, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 1, Row: 0},
+		},
+		{
+			symmetry: Symmetry{Transpose: true, FlipRows: true},
+			args:     args{square, models.Point{Column: 0, Row: 1}},
+			want:     models.Point{Column: 1, Row: 2},
+		},
+	} {
+		got := data.symmetry.Apply(data.args.size, data.args.point)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSymmetries(test *testing.T) {
+	type args struct {
+		storage models.StoneStorage
+	}
+	type data struct {
+		args args
+		want []Symmetry
+	}
+
+	square := models.Size{Width: 3, Height: 3}
+	for _, data := range []data{
+		{
+			args: args{models.NewBoard(square)},
+			want: []Symmetry{
+				{},
+				{FlipRows: true},
+				{FlipColumns: true},
+				{FlipColumns: true, FlipRows: true},
+				{Transpose: true},
+				{Transpose: true, FlipRows: true},
+				{Transpose: true, FlipColumns: true},
+				{Transpose: true, FlipColumns: true, FlipRows: true},
+			},
+		},
+		{
+			args: args{models.NewBoard(models.Size{Width: 3, Height: 2})},
+			want: []Symmetry{
+				{},
+				{FlipRows: true},
+				{FlipColumns: true},
+				{FlipColumns: true, FlipRows: true},
+			},
+		},
+		{
+			args: args{
+				models.NewBoard(square).ApplyMove(models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: 0, Row: 0},
+				}),
+			},
+			want: []Symmetry{
+				{},
+				{Transpose: true},
+			},
+		},
+		{
+			args: args{
+				models.NewBoard(square).ApplyMove(models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: 1, Row: 0},
+				}),
+			},
+			want: []Symmetry{
+				{},
+				{FlipColumns: true},
+			},
+		},
+		{
+			args: args{
+				models.NewBoard(square).
+					ApplyMove(models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 0, Row: 0},
+					}).
+					ApplyMove(models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 2, Row: 2},
+					}),
+			},
+			want: []Symmetry{
+				{},
+				{Transpose: true},
+			},
+		},
+		{
+			args: args{
+				models.NewBoard(square).
+					ApplyMove(models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 0, Row: 0},
+					}).
+					ApplyMove(models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 2, Row: 0},
+					}),
+			},
+			want: []Symmetry{{}},
+		},
+	} {
+		got := Symmetries(data.args.storage)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestUniqueMoves(test *testing.T) {
+	type args struct {
+		storage models.StoneStorage
+		moves   []models.Move
+	}
+	type data struct {
+		args args
+		want []models.Move
+	}
+
+	square := models.Size{Width: 3, Height: 3}
+	blackMove := func(column int, row int) models.Move {
+		return models.Move{
+			Color: models.Black,
+			Point: models.Point{Column: column, Row: row},
+		}
+	}
+	var allMoves []models.Move
+	for _, point := range square.Points() {
+		allMoves = append(allMoves, blackMove(point.Column, point.Row))
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: models.NewBoard(square),
+				moves:   []models.Move{blackMove(2, 2), blackMove(0, 0)},
+			},
+			want: []models.Move{blackMove(2, 2)},
+		},
+		{
+			args: args{
+				storage: models.NewBoard(square),
+				moves:   allMoves,
+			},
+			want: []models.Move{blackMove(0, 0), blackMove(1, 0), blackMove(1, 1)},
+		},
+		{
+			args: args{
+				storage: models.NewBoard(square).ApplyMove(blackMove(1, 0)),
+				moves:   []models.Move{blackMove(0, 0), blackMove(2, 0)},
+			},
+			want: []models.Move{blackMove(0, 0)},
+		},
+		{
+			args: args{
+				storage: models.NewBoard(square).
+					ApplyMove(blackMove(0, 0)).
+					ApplyMove(blackMove(1, 0)),
+				moves: []models.Move{blackMove(2, 2), blackMove(0, 2)},
+			},
+			want: []models.Move{blackMove(2, 2), blackMove(0, 2)},
+		},
+	} {
+		got := UniqueMoves(data.args.storage, data.args.moves)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSymmetricMoveGeneratorLegalMoves(test *testing.T) {
+	type args struct {
+		storage      models.StoneStorage
+		previousMove models.Move
+	}
+	type data struct {
+		args          args
+		wantMoveCount int
+		wantErr       bool
+	}
+
+	root := &tree.Node{
+		Move: models.NewPreliminaryMove(models.White),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}).
+			ApplyMove(models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 1, Row: 1},
+			}),
+	}
+	whiteMove := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	for _, data := range []data{
+		{
+			args:          args{root.Storage, root.Move},
+			wantMoveCount: 2,
+			wantErr:       false,
+		},
+		{
+			args: args{
+				storage:      root.Storage,
+				previousMove: models.NewPreliminaryMove(models.Black),
+			},
+			wantMoveCount: 8,
+			wantErr:       false,
+		},
+		{
+			args: args{
+				storage:      root.Storage.ApplyMove(whiteMove),
+				previousMove: whiteMove,
+			},
+			wantMoveCount: 7,
+			wantErr:       false,
+		},
+	} {
+		generator := NewSymmetricMoveGenerator(models.MoveGenerator{}, root)
+		got, err := generator.LegalMoves(
+			data.args.storage,
+			data.args.previousMove,
+		)
+
+		if len(got) != data.wantMoveCount {
+			test.Fail()
+		}
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestSymmetricMoveGeneratorWithParallelBuilder(test *testing.T) {
+	root := &tree.Node{
+		Move: models.NewPreliminaryMove(models.White),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}).
+			ApplyMove(models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 1, Row: 1},
+			}),
+	}
+	builder := builders.ParallelBuilder{
+		Builder: TreeBuilder{
+			NodeSelector:  firstNodeSelector{},
+			MoveGenerator: NewSymmetricMoveGenerator(models.MoveGenerator{}, root),
+			MoveSelector:  firstMoveSelector{},
+		},
+		Concurrency: 2,
+	}
+	builder.Pass(root)
+
+	if len(root.Children) != 2 {
+		test.Fail()
+	}
+	for _, child := range root.Children {
+		if child.Move.Color != models.White {
+			test.Fail()
+		}
+	}
+}
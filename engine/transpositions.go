@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// TranspositionTable ...
+//
+// It accumulates statistics of nodes by their positions and colors to move,
+// so the statistics are shared between transpositions (i.e. the same
+// positions reached by different move orders). It's safe for concurrent use.
+//
+type TranspositionTable struct {
+	hasher ZobristHasher
+	mutex  sync.RWMutex
+	states map[uint64]tree.NodeState
+}
+
+// NewTranspositionTable ...
+func NewTranspositionTable(hasher ZobristHasher) *TranspositionTable {
+	return &TranspositionTable{
+		hasher: hasher,
+		states: make(map[uint64]tree.NodeState),
+	}
+}
+
+// State ...
+//
+// It returns the statistics accumulated for the position of the node.
+//
+func (table *TranspositionTable) State(node *tree.Node) tree.NodeState {
+	key := table.key(node)
+
+	table.mutex.RLock()
+	defer table.mutex.RUnlock()
+
+	return table.states[key]
+}
+
+// Update ...
+//
+// It adds the state to the statistics of the position of the node
+// and returns the result.
+//
+func (table *TranspositionTable) Update(
+	node *tree.Node,
+	state tree.NodeState,
+) tree.NodeState {
+	key := table.key(node)
+
+	table.mutex.Lock()
+	defer table.mutex.Unlock()
+
+	currentState := table.states[key]
+	currentState.GameCount += state.GameCount
+	currentState.WinCount += state.WinCount
+	table.states[key] = currentState
+
+	return currentState
+}
+
+// node states are for colors of node moves, so the color to move
+// is the opposite one
+func (table *TranspositionTable) key(node *tree.Node) uint64 {
+	return table.hasher.Hash(node.Storage, node.Move.Color.Negative())
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestTranspositionTable(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	blackStone := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	whiteStone := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 1},
+	}
+	firstNode := &tree.Node{
+		Move:    whiteStone,
+		Storage: models.NewBoard(size).ApplyMove(blackStone).ApplyMove(whiteStone),
+	}
+	secondNode := &tree.Node{
+		Move:    whiteStone,
+		Storage: models.NewBoard(size).ApplyMove(whiteStone).ApplyMove(blackStone),
+	}
+	otherNode := &tree.Node{
+		Move:    blackStone,
+		Storage: secondNode.Storage,
+	}
+
+	table := NewTranspositionTable(NewZobristHasher(size))
+	got := table.Update(firstNode, tree.NodeState{GameCount: 2, WinCount: 1})
+	if !reflect.DeepEqual(got, tree.NodeState{GameCount: 2, WinCount: 1}) {
+		test.Fail()
+	}
+
+	got = table.Update(secondNode, tree.NodeState{GameCount: 1, WinCount: 1})
+	if !reflect.DeepEqual(got, tree.NodeState{GameCount: 3, WinCount: 2}) {
+		test.Fail()
+	}
+
+	got = table.State(firstNode)
+	if !reflect.DeepEqual(got, tree.NodeState{GameCount: 3, WinCount: 2}) {
+		test.Fail()
+	}
+
+	got = table.State(otherNode)
+	if !reflect.DeepEqual(got, tree.NodeState{}) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"math/rand"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// it's fixed, so hashes are stable between runs (e.g. for opening books)
+const zobristSeed = 0x5a0b215
+
+// ZobristHasher ...
+//
+// It hashes positions by the Zobrist method. Hashes are stable between runs
+// for the same board size.
+//
+type ZobristHasher struct {
+	size        models.Size
+	stoneKeys   [][2]uint64
+	whiteToMove uint64
+}
+
+// NewZobristHasher ...
+func NewZobristHasher(size models.Size) ZobristHasher {
+	generator := rand.New(rand.NewSource(zobristSeed)) // nolint: gosec
+	stoneKeys := make([][2]uint64, size.Width*size.Height)
+	for index := range stoneKeys {
+		stoneKeys[index] = [2]uint64{generator.Uint64(), generator.Uint64()}
+	}
+
+	return ZobristHasher{
+		size:        size,
+		stoneKeys:   stoneKeys,
+		whiteToMove: generator.Uint64(),
+	}
+}
+
+// Hash ...
+//
+// It hashes the storage and the color to move. The storage should have
+// the same size as the hasher.
+//
+func (hasher ZobristHasher) Hash(
+	storage models.StoneStorage,
+	colorToMove models.Color,
+) uint64 {
+	var hash uint64
+	for _, point := range hasher.size.Points() {
+		if color, ok := storage.Stone(point); ok {
+			index := point.Row*hasher.size.Width + point.Column
+			hash ^= hasher.stoneKeys[index][colorIndex(color)]
+		}
+	}
+	if colorToMove == models.White {
+		hash ^= hasher.whiteToMove
+	}
+
+	return hash
+}
+
+func colorIndex(color models.Color) int {
+	if color == models.Black {
+		return 0
+	}
+
+	return 1
+}
@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestZobristHasherHash(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	makeBoard := func(moves []models.Move) models.StoneStorage {
+		board := models.NewBoard(size)
+		for _, move := range moves {
+			board = board.ApplyMove(move)
+		}
+
+		return board
+	}
+
+	blackStone := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	whiteStone := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 1},
+	}
+	firstBoard := makeBoard([]models.Move{blackStone, whiteStone})
+	secondBoard := makeBoard([]models.Move{whiteStone, blackStone})
+
+	hasher := NewZobristHasher(size)
+	firstHash := hasher.Hash(firstBoard, models.Black)
+	if firstHash != hasher.Hash(secondBoard, models.Black) {
+		test.Fail()
+	}
+	if firstHash != NewZobristHasher(size).Hash(secondBoard, models.Black) {
+		test.Fail()
+	}
+	if firstHash == hasher.Hash(firstBoard, models.White) {
+		test.Fail()
+	}
+	thirdBoard := makeBoard([]models.Move{blackStone})
+	if firstHash == hasher.Hash(thirdBoard, models.Black) {
+		test.Fail()
+	}
+	if hasher.Hash(models.NewBoard(size), models.Black) != 0 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrTimeIsOver ...
+var ErrTimeIsOver = errors.New("time is over") // nolint: gochecknoglobals
+
+// TimeControl ...
+//
+// It's absolute, if only the main time is set, with the Fischer increment,
+// if the increment is set, or byo-yomi, if periods are set. In byo-yomi,
+// a period is used up only if a move takes longer than it.
+//
+type TimeControl struct {
+	MainTime   time.Duration
+	Increment  time.Duration
+	Periods    int
+	PeriodTime time.Duration
+}
+
+// DecodeTimeControl ...
+//
+// It decodes time controls like "absolute:5m", "fischer:5m+10s"
+// and "byoyomi:5m+3x30s" (i.e. 3 periods of 30 seconds). Durations are
+// in the format of time.ParseDuration().
+//
+func DecodeTimeControl(text string) (TimeControl, error) {
+	parts := strings.SplitN(text, ":", 2)
+	if len(parts) != 2 {
+		return TimeControl{}, errors.New("missed kind of the time control")
+	}
+
+	kind, parameters := parts[0], strings.Split(parts[1], "+")
+	var control TimeControl
+	var err error
+	switch {
+	case kind == "absolute" && len(parameters) == 1:
+		control.MainTime, err = decodePositiveDuration(parameters[0])
+	case kind == "fischer" && len(parameters) == 2:
+		control.MainTime, err = decodePositiveDuration(parameters[0])
+		if err == nil {
+			control.Increment, err = decodePositiveDuration(parameters[1])
+		}
+	case kind == "byoyomi" && len(parameters) == 2:
+		control.MainTime, err = time.ParseDuration(parameters[0])
+		if err == nil && control.MainTime < 0 {
+			err = errors.New("negative duration")
+		}
+		if err == nil {
+			control.Periods, control.PeriodTime, err =
+				decodePeriods(parameters[1])
+		}
+	default:
+		return TimeControl{}, fmt.Errorf("unknown time control %q", text)
+	}
+	if err != nil {
+		return TimeControl{}, fmt.Errorf(
+			"unable to decode the time control %q: %s",
+			text,
+			err,
+		)
+	}
+
+	return control, nil
+}
+
+// Clock ...
+//
+// It keeps the remaining time of a player.
+//
+type Clock struct {
+	Control  TimeControl
+	MainTime time.Duration
+	Periods  int
+}
+
+// NewClock ...
+func NewClock(control TimeControl) Clock {
+	return Clock{
+		Control:  control,
+		MainTime: control.MainTime,
+		Periods:  control.Periods,
+	}
+}
+
+// Expired ...
+func (clock Clock) Expired() bool {
+	return clock.MainTime == 0 && clock.Periods == 0
+}
+
+// Spend ...
+//
+// It returns the clock after a move taken the elapsed time. It returns
+// ErrTimeIsOver, if the clock has expired during the move.
+//
+func (clock Clock) Spend(elapsed time.Duration) (Clock, error) {
+	if elapsed < clock.MainTime {
+		clock.MainTime += clock.Control.Increment - elapsed
+		return clock, nil
+	}
+
+	elapsed -= clock.MainTime
+	clock.MainTime = 0
+	for clock.Periods > 0 && elapsed >= clock.Control.PeriodTime {
+		elapsed -= clock.Control.PeriodTime
+		clock.Periods--
+	}
+	if clock.Periods == 0 {
+		return clock, ErrTimeIsOver
+	}
+
+	return clock, nil
+}
+
+// Remaining ...
+//
+// It returns the time until the clock expires during a move, i.e. the main
+// time and all the remaining byo-yomi periods.
+//
+func (clock Clock) Remaining() time.Duration {
+	periodsTime := time.Duration(clock.Periods) * clock.Control.PeriodTime
+	return clock.MainTime + periodsTime
+}
+
+// Allocate ...
+//
+// It returns thinking time for a move, if the specified count of moves
+// is expected until the game end. It's a share of the main time
+// with the increment and the most of a byo-yomi period.
+//
+func (clock Clock) Allocate(expectedMoves int) time.Duration {
+	if expectedMoves < 1 {
+		expectedMoves = 1
+	}
+
+	// the tenth of a limit is left for overheads
+	allocation := clock.MainTime/time.Duration(expectedMoves) +
+		clock.Control.Increment
+	if limit := clock.MainTime * 9 / 10; allocation > limit {
+		allocation = limit
+	}
+	if clock.Periods > 0 {
+		allocation += clock.Control.PeriodTime * 9 / 10
+	}
+
+	return allocation
+}
+
+// String ...
+//
+// It returns the main time like "4:05" or "1:04:05" and the byo-yomi
+// periods, if they are used, like "0:00 (3x0:30)".
+//
+func (clock Clock) String() string {
+	text := formatDuration(clock.MainTime)
+	if clock.Control.Periods > 0 {
+		text += fmt.Sprintf(
+			" (%dx%s)",
+			clock.Periods,
+			formatDuration(clock.Control.PeriodTime),
+		)
+	}
+
+	return text
+}
+
+func decodePositiveDuration(text string) (time.Duration, error) {
+	duration, err := time.ParseDuration(text)
+	if err != nil {
+		return 0, err // don't wrap
+	}
+	if duration <= 0 {
+		return 0, errors.New("non-positive duration")
+	}
+
+	return duration, nil
+}
+
+func decodePeriods(
+	text string,
+) (periods int, periodTime time.Duration, err error) {
+	parts := strings.SplitN(text, "x", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.New("incorrect periods format")
+	}
+
+	periods, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to decode the period count: %s", err)
+	}
+	if periods <= 0 {
+		return 0, 0, errors.New("non-positive period count")
+	}
+
+	periodTime, err = decodePositiveDuration(parts[1])
+	if err != nil {
+		return 0, 0, err // don't wrap
+	}
+
+	return periods, periodTime, nil
+}
+
+func formatDuration(duration time.Duration) string {
+	seconds := int(duration / time.Second)
+	if seconds >= 3600 {
+		return fmt.Sprintf(
+			"%d:%02d:%02d",
+			seconds/3600,
+			seconds/60%60,
+			seconds%60,
+		)
+	}
+
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,339 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDecodeTimeControl(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args    args
+		want    TimeControl
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"absolute:5m"},
+			want:    TimeControl{MainTime: 5 * time.Minute},
+			wantErr: false,
+		},
+		{
+			args: args{"fischer:5m+10s"},
+			want: TimeControl{
+				MainTime:  5 * time.Minute,
+				Increment: 10 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"byoyomi:5m+3x30s"},
+			want: TimeControl{
+				MainTime:   5 * time.Minute,
+				Periods:    3,
+				PeriodTime: 30 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"byoyomi:0s+5x10s"},
+			want: TimeControl{
+				Periods:    5,
+				PeriodTime: 10 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			args:    args{"5m"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"sudden:5m"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"absolute:0s"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"fischer:5m"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"fischer:5m+ten"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"byoyomi:5m+30s"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"byoyomi:5m+0x30s"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+		{
+			args:    args{"byoyomi:-5m+3x30s"},
+			want:    TimeControl{},
+			wantErr: true,
+		},
+	} {
+		got, err := DecodeTimeControl(data.args.text)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestClockSpend(test *testing.T) {
+	type args struct {
+		elapsed time.Duration
+	}
+	type data struct {
+		clock   Clock
+		args    args
+		want    Clock
+		wantErr error
+	}
+
+	absolute := TimeControl{MainTime: time.Minute}
+	fischer := TimeControl{MainTime: time.Minute, Increment: 5 * time.Second}
+	byoyomi := TimeControl{
+		MainTime:   time.Minute,
+		Periods:    3,
+		PeriodTime: 10 * time.Second,
+	}
+	for _, data := range []data{
+		{
+			clock: NewClock(absolute),
+			args:  args{20 * time.Second},
+			want: Clock{
+				Control:  absolute,
+				MainTime: 40 * time.Second,
+			},
+			wantErr: nil,
+		},
+		{
+			clock: NewClock(absolute),
+			args:  args{time.Minute},
+			want: Clock{
+				Control: absolute,
+			},
+			wantErr: ErrTimeIsOver,
+		},
+		{
+			clock: NewClock(fischer),
+			args:  args{20 * time.Second},
+			want: Clock{
+				Control:  fischer,
+				MainTime: 45 * time.Second,
+			},
+			wantErr: nil,
+		},
+		{
+			clock: NewClock(byoyomi),
+			args:  args{65 * time.Second},
+			want: Clock{
+				Control: byoyomi,
+				Periods: 3,
+			},
+			wantErr: nil,
+		},
+		{
+			clock: NewClock(byoyomi),
+			args:  args{85 * time.Second},
+			want: Clock{
+				Control: byoyomi,
+				Periods: 1,
+			},
+			wantErr: nil,
+		},
+		{
+			clock: Clock{
+				Control: byoyomi,
+				Periods: 1,
+			},
+			args: args{10 * time.Second},
+			want: Clock{
+				Control: byoyomi,
+			},
+			wantErr: ErrTimeIsOver,
+		},
+	} {
+		got, err := data.clock.Spend(data.args.elapsed)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestClockExpired(test *testing.T) {
+	type data struct {
+		clock Clock
+		want  bool
+	}
+
+	for _, data := range []data{
+		{
+			clock: Clock{MainTime: time.Second},
+			want:  false,
+		},
+		{
+			clock: Clock{Periods: 1},
+			want:  false,
+		},
+		{
+			clock: Clock{},
+			want:  true,
+		},
+	} {
+		got := data.clock.Expired()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestClockRemaining(test *testing.T) {
+	type data struct {
+		clock Clock
+		want  time.Duration
+	}
+
+	for _, data := range []data{
+		{
+			clock: NewClock(TimeControl{MainTime: time.Minute}),
+			want:  time.Minute,
+		},
+		{
+			clock: NewClock(TimeControl{
+				MainTime:   time.Minute,
+				Periods:    3,
+				PeriodTime: 10 * time.Second,
+			}),
+			want: 90 * time.Second,
+		},
+		{
+			clock: Clock{},
+			want:  0,
+		},
+	} {
+		got := data.clock.Remaining()
+
+		if got != data.want {
+			test.Fail()
+		}
+
+		// the clock expires exactly after the remaining time
+		if _, err := data.clock.Spend(got); err != ErrTimeIsOver {
+			test.Fail()
+		}
+	}
+}
+
+func TestClockAllocate(test *testing.T) {
+	type args struct {
+		expectedMoves int
+	}
+	type data struct {
+		clock Clock
+		args  args
+		want  time.Duration
+	}
+
+	for _, data := range []data{
+		{
+			clock: NewClock(TimeControl{MainTime: time.Minute}),
+			args:  args{10},
+			want:  6 * time.Second,
+		},
+		{
+			clock: NewClock(TimeControl{MainTime: time.Minute}),
+			args:  args{0},
+			want:  54 * time.Second,
+		},
+		{
+			clock: NewClock(TimeControl{
+				MainTime:  time.Minute,
+				Increment: 4 * time.Second,
+			}),
+			args: args{10},
+			want: 10 * time.Second,
+		},
+		{
+			clock: NewClock(TimeControl{
+				MainTime:   time.Minute,
+				Periods:    3,
+				PeriodTime: 10 * time.Second,
+			}),
+			args: args{10},
+			want: 15 * time.Second,
+		},
+		{
+			clock: Clock{
+				Control: TimeControl{Periods: 3, PeriodTime: 10 * time.Second},
+				Periods: 1,
+			},
+			args: args{10},
+			want: 9 * time.Second,
+		},
+	} {
+		got := data.clock.Allocate(data.args.expectedMoves)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestClockString(test *testing.T) {
+	type data struct {
+		clock Clock
+		want  string
+	}
+
+	for _, data := range []data{
+		{
+			clock: Clock{MainTime: 245 * time.Second},
+			want:  "4:05",
+		},
+		{
+			clock: Clock{
+				MainTime: time.Hour + 245*time.Second + time.Millisecond,
+			},
+			want: "1:04:05",
+		},
+		{
+			clock: Clock{
+				Control: TimeControl{Periods: 3, PeriodTime: 30 * time.Second},
+				Periods: 2,
+			},
+			want: "0:00 (2x0:30)",
+		},
+	} {
+		got := data.clock.String()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
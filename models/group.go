@@ -0,0 +1,150 @@
+package models
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// Group ...
+//
+// It's a chain of connected stones of the same color.
+//
+type Group struct {
+	Color     models.Color
+	Points    []models.Point
+	Liberties []models.Point
+}
+
+// FindGroups ...
+//
+// Groups are ordered by their first points, and the points and the liberties
+// of each group are ordered as in models.Size.Points().
+//
+func FindGroups(storage models.StoneStorage) []Group {
+	visitedPoints := make(map[models.Point]bool)
+
+	var groups []Group
+	for _, point := range storage.Size().Points() {
+		if visitedPoints[point] {
+			continue
+		}
+
+		group, ok := findGroup(storage, point, visitedPoints)
+		if !ok {
+			continue
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// FindGroup ...
+//
+// It returns the group containing a stone at the specified point, or false
+// as the second result, if the point is empty. The points and the liberties
+// of the group are ordered as in models.Size.Points().
+//
+func FindGroup(
+	storage models.StoneStorage,
+	point models.Point,
+) (group Group, ok bool) {
+	return findGroup(storage, point, make(map[models.Point]bool))
+}
+
+// FindAtariGroups ...
+//
+// It returns groups that have exactly one liberty.
+//
+func FindAtariGroups(storage models.StoneStorage) []Group {
+	var atariGroups []Group
+	for _, group := range FindGroups(storage) {
+		if group.InAtari() {
+			atariGroups = append(atariGroups, group)
+		}
+	}
+
+	return atariGroups
+}
+
+// InAtari ...
+func (group Group) InAtari() bool {
+	return len(group.Liberties) == 1
+}
+
+func findGroup(
+	storage models.StoneStorage,
+	point models.Point,
+	visitedPoints map[models.Point]bool,
+) (group Group, ok bool) {
+	color, ok := storage.Stone(point)
+	if !ok {
+		return Group{}, false
+	}
+
+	size := storage.Size()
+	groupPoints := make(map[models.Point]bool)
+	libertyPoints := make(map[models.Point]bool)
+	queue := []models.Point{point}
+	visitedPoints[point] = true
+	for len(queue) != 0 {
+		currentPoint := queue[0]
+		queue = queue[1:]
+		groupPoints[currentPoint] = true
+
+		for _, neighbor := range neighbors(size, currentPoint) {
+			neighborColor, ok := storage.Stone(neighbor)
+			switch {
+			case !ok:
+				libertyPoints[neighbor] = true
+			case neighborColor == color && !visitedPoints[neighbor]:
+				visitedPoints[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	group = Group{
+		Color:     color,
+		Points:    orderPoints(size, groupPoints),
+		Liberties: orderPoints(size, libertyPoints),
+	}
+	return group, true
+}
+
+func neighbors(size models.Size, point models.Point) []models.Point {
+	var points []models.Point
+	for _, shift := range []models.Point{
+		{Column: -1, Row: 0},
+		{Column: 1, Row: 0},
+		{Column: 0, Row: -1},
+		{Column: 0, Row: 1},
+	} {
+		neighbor := models.Point{
+			Column: point.Column + shift.Column,
+			Row:    point.Row + shift.Row,
+		}
+		if neighbor.Column < 0 || neighbor.Column >= size.Width ||
+			neighbor.Row < 0 || neighbor.Row >= size.Height {
+			continue
+		}
+
+		points = append(points, neighbor)
+	}
+
+	return points
+}
+
+func orderPoints(
+	size models.Size,
+	pointSet map[models.Point]bool,
+) []models.Point {
+	var points []models.Point
+	for _, point := range size.Points() {
+		if pointSet[point] {
+			points = append(points, point)
+		}
+	}
+
+	return points
+}
@@ -0,0 +1,214 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestFindGroups(test *testing.T) {
+	type args struct {
+		storage models.StoneStorage
+	}
+	type data struct {
+		args args
+		want []Group
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(models.Size{Width: 3, Height: 3})
+					for _, move := range []models.Move{
+						{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+						{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+						{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+						{Color: models.White, Point: models.Point{Column: 2, Row: 2}},
+					} {
+						board = board.ApplyMove(move)
+					}
+
+					return board
+				}(),
+			},
+			want: []Group{
+				{
+					Color: models.Black,
+					Points: []models.Point{
+						{Column: 0, Row: 0},
+						{Column: 1, Row: 0},
+					},
+					Liberties: []models.Point{
+						{Column: 2, Row: 0},
+						{Column: 1, Row: 1},
+					},
+				},
+				{
+					Color: models.White,
+					Points: []models.Point{
+						{Column: 0, Row: 1},
+					},
+					Liberties: []models.Point{
+						{Column: 1, Row: 1},
+						{Column: 0, Row: 2},
+					},
+				},
+				{
+					Color: models.White,
+					Points: []models.Point{
+						{Column: 2, Row: 2},
+					},
+					Liberties: []models.Point{
+						{Column: 2, Row: 1},
+						{Column: 1, Row: 2},
+					},
+				},
+			},
+		},
+	} {
+		got := FindGroups(data.args.storage)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestFindGroup(test *testing.T) {
+	type args struct {
+		point models.Point
+	}
+	type data struct {
+		args      args
+		wantGroup Group
+		wantOk    bool
+	}
+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+	} {
+		board = board.ApplyMove(move)
+	}
+
+	for _, data := range []data{
+		{
+			args: args{models.Point{Column: 1, Row: 0}},
+			wantGroup: Group{
+				Color: models.Black,
+				Points: []models.Point{
+					{Column: 0, Row: 0},
+					{Column: 1, Row: 0},
+				},
+				Liberties: []models.Point{
+					{Column: 2, Row: 0},
+					{Column: 1, Row: 1},
+				},
+			},
+			wantOk: true,
+		},
+		{
+			args:      args{models.Point{Column: 2, Row: 2}},
+			wantGroup: Group{},
+			wantOk:    false,
+		},
+	} {
+		gotGroup, gotOk := FindGroup(board, data.args.point)
+
+		if !reflect.DeepEqual(gotGroup, data.wantGroup) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestFindAtariGroups(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 1, Row: 1}},
+	} {
+		board = board.ApplyMove(move)
+	}
+
+	got := FindAtariGroups(board)
+
+	want := []Group{
+		{
+			Color: models.Black,
+			Points: []models.Point{
+				{Column: 0, Row: 0},
+			},
+			Liberties: []models.Point{
+				{Column: 0, Row: 1},
+			},
+		},
+		{
+			Color: models.White,
+			Points: []models.Point{
+				{Column: 1, Row: 0},
+			},
+			Liberties: []models.Point{
+				{Column: 2, Row: 0},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestGroupInAtari(test *testing.T) {
+	type fields struct {
+		liberties []models.Point
+	}
+	type data struct {
+		fields fields
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				liberties: nil,
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				liberties: []models.Point{{Column: 1, Row: 2}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				liberties: []models.Point{{Column: 1, Row: 2}, {Column: 2, Row: 1}},
+			},
+			want: false,
+		},
+	} {
+		group := Group{
+			Color:     models.Black,
+			Points:    []models.Point{{Column: 1, Row: 1}},
+			Liberties: data.fields.liberties,
+		}
+		got := group.InAtari()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
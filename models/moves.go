@@ -0,0 +1,49 @@
+package models
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// MovePoints ...
+type MovePoints struct {
+	Legal []models.Point
+	// empty points, where moves are forbidden (e.g. because of self-capture)
+	Illegal []models.Point
+}
+
+// FindMovePoints ...
+//
+// It classifies empty points for moves of the specified color. It returns
+// an error, if the game is already over.
+//
+func FindMovePoints(
+	storage models.StoneStorage,
+	color models.Color,
+) (MovePoints, error) {
+	generator := models.MoveGenerator{}
+	moves, err :=
+		generator.LegalMoves(storage, models.NewPreliminaryMove(color))
+	if err != nil {
+		return MovePoints{}, err // don't wrap
+	}
+
+	legalPoints := make(map[models.Point]bool)
+	for _, move := range moves {
+		legalPoints[move.Point] = true
+	}
+
+	var points MovePoints
+	for _, point := range storage.Size().Points() {
+		if _, ok := storage.Stone(point); ok {
+			continue
+		}
+
+		if legalPoints[point] {
+			points.Legal = append(points.Legal, point)
+		} else {
+			points.Illegal = append(points.Illegal, point)
+		}
+	}
+
+	return points, nil
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestFindMovePoints(test *testing.T) {
+	type args struct {
+		storage models.StoneStorage
+		color   models.Color
+	}
+	type data struct {
+		args    args
+		want    MovePoints
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(models.Size{Width: 3, Height: 3})
+					for _, move := range []models.Move{
+						{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+						{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+					} {
+						board = board.ApplyMove(move)
+					}
+
+					return board
+				}(),
+				color: models.Black,
+			},
+			want: MovePoints{
+				Legal: []models.Point{
+					{Column: 2, Row: 0},
+					{Column: 1, Row: 1},
+					{Column: 2, Row: 1},
+					{Column: 0, Row: 2},
+					{Column: 1, Row: 2},
+					{Column: 2, Row: 2},
+				},
+				Illegal: []models.Point{
+					{Column: 0, Row: 0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				storage: func() models.StoneStorage {
+					board := models.NewBoard(models.Size{Width: 2, Height: 2})
+					for _, move := range []models.Move{
+						{Color: models.White, Point: models.Point{Column: 0, Row: 0}},
+						{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+						{Color: models.Black, Point: models.Point{Column: 0, Row: 1}},
+					} {
+						board = board.ApplyMove(move)
+					}
+
+					return board
+				}(),
+				color: models.White,
+			},
+			want:    MovePoints{},
+			wantErr: true,
+		},
+	} {
+		got, gotErr := FindMovePoints(data.args.storage, data.args.color)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// StarPoints ...
+//
+// It returns conventional star points (hoshi) for the standard square sizes:
+// 9x9, 13x13 and 19x19. For other sizes, it returns nil.
+//
+func StarPoints(size models.Size) []models.Point {
+	if size.Width != size.Height {
+		return nil
+	}
+
+	var edgeDistance int
+	switch size.Width {
+	case 9:
+		edgeDistance = 2
+	case 13, 19:
+		edgeDistance = 3
+	default:
+		return nil
+	}
+
+	axes := []int{edgeDistance, size.Width / 2, size.Width - edgeDistance - 1}
+	var points []models.Point
+	for rowIndex, row := range axes {
+		for columnIndex, column := range axes {
+			// only the 19x19 board has star points in the middles of the sides
+			isSidePoint := (rowIndex == 1) != (columnIndex == 1)
+			if isSidePoint && size.Width != 19 {
+				continue
+			}
+
+			points = append(points, models.Point{Column: column, Row: row})
+		}
+	}
+
+	return points
+}
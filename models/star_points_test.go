@@ -0,0 +1,69 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestStarPoints(test *testing.T) {
+	type args struct {
+		size models.Size
+	}
+	type data struct {
+		args args
+		want []models.Point
+	}
+
+	for _, data := range []data{
+		{
+			args: args{models.Size{Width: 5, Height: 5}},
+			want: nil,
+		},
+		{
+			args: args{models.Size{Width: 9, Height: 13}},
+			want: nil,
+		},
+		{
+			args: args{models.Size{Width: 9, Height: 9}},
+			want: []models.Point{
+				{Column: 2, Row: 2},
+				{Column: 6, Row: 2},
+				{Column: 4, Row: 4},
+				{Column: 2, Row: 6},
+				{Column: 6, Row: 6},
+			},
+		},
+		{
+			args: args{models.Size{Width: 13, Height: 13}},
+			want: []models.Point{
+				{Column: 3, Row: 3},
+				{Column: 9, Row: 3},
+				{Column: 6, Row: 6},
+				{Column: 3, Row: 9},
+				{Column: 9, Row: 9},
+			},
+		},
+		{
+			args: args{models.Size{Width: 19, Height: 19}},
+			want: []models.Point{
+				{Column: 3, Row: 3},
+				{Column: 9, Row: 3},
+				{Column: 15, Row: 3},
+				{Column: 3, Row: 9},
+				{Column: 9, Row: 9},
+				{Column: 15, Row: 9},
+				{Column: 3, Row: 15},
+				{Column: 9, Row: 15},
+				{Column: 15, Row: 15},
+			},
+		},
+	} {
+		got := StarPoints(data.args.size)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
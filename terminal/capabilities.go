@@ -0,0 +1,62 @@
+package terminal
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvironmentGetter ...
+//
+// It should have the same semantics as os.LookupEnv().
+//
+type EnvironmentGetter func(key string) (value string, ok bool)
+
+// Capabilities ...
+type Capabilities struct {
+	Colors  bool
+	Unicode bool
+}
+
+// DetectCapabilities ...
+//
+// It supports colors only for a terminal, and respects the NO_COLOR
+// (see https://no-color.org/) and TERM environment variables. It supports
+// Unicode only if the locale (by the LC_ALL, LC_CTYPE or LANG environment
+// variables, in this priority) uses the UTF-8 encoding.
+//
+func DetectCapabilities(
+	isTerminal bool,
+	environmentGetter EnvironmentGetter,
+) Capabilities {
+	term, _ := environmentGetter("TERM")
+	isDumbTerm := term == "dumb"
+
+	noColor, _ := environmentGetter("NO_COLOR")
+	colors := isTerminal && !isDumbTerm && noColor == ""
+
+	var locale string
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value, ok := environmentGetter(key); ok && value != "" {
+			locale = value
+			break
+		}
+	}
+	locale = strings.ToLower(locale)
+	unicode := !isDumbTerm &&
+		(strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8"))
+
+	return Capabilities{
+		Colors:  colors,
+		Unicode: unicode,
+	}
+}
+
+// IsTerminal ...
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
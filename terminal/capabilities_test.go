@@ -0,0 +1,104 @@
+package terminal
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDetectCapabilities(test *testing.T) {
+	type args struct {
+		isTerminal  bool
+		environment map[string]string
+	}
+	type data struct {
+		args args
+		want Capabilities
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				isTerminal: true,
+				environment: map[string]string{
+					"TERM": "xterm-256color",
+					"LANG": "en_US.UTF-8",
+				},
+			},
+			want: Capabilities{Colors: true, Unicode: true},
+		},
+		{
+			args: args{
+				isTerminal: false,
+				environment: map[string]string{
+					"TERM": "xterm-256color",
+					"LANG": "en_US.UTF-8",
+				},
+			},
+			want: Capabilities{Colors: false, Unicode: true},
+		},
+		{
+			args: args{
+				isTerminal: true,
+				environment: map[string]string{
+					"TERM":     "xterm-256color",
+					"LANG":     "en_US.utf8",
+					"NO_COLOR": "1",
+				},
+			},
+			want: Capabilities{Colors: false, Unicode: true},
+		},
+		{
+			args: args{
+				isTerminal: true,
+				environment: map[string]string{
+					"TERM": "dumb",
+					"LANG": "en_US.UTF-8",
+				},
+			},
+			want: Capabilities{Colors: false, Unicode: false},
+		},
+		{
+			args: args{
+				isTerminal: true,
+				environment: map[string]string{
+					"TERM":   "xterm",
+					"LC_ALL": "C",
+					"LANG":   "en_US.UTF-8",
+				},
+			},
+			want: Capabilities{Colors: true, Unicode: false},
+		},
+		{
+			args: args{
+				isTerminal:  true,
+				environment: map[string]string{},
+			},
+			want: Capabilities{Colors: true, Unicode: false},
+		},
+	} {
+		environment := data.args.environment
+		got := DetectCapabilities(
+			data.args.isTerminal,
+			func(key string) (value string, ok bool) {
+				value, ok = environment[key]
+				return value, ok
+			},
+		)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsTerminal(test *testing.T) {
+	file, err := os.Open("capabilities.go")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer file.Close()
+
+	if IsTerminal(file) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,142 @@
+package terminal
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Key ...
+type Key int
+
+// ...
+const (
+	UnknownKey Key = iota
+	UpKey
+	DownKey
+	LeftKey
+	RightKey
+	EnterKey
+	QuitKey
+	MouseClickKey
+)
+
+// Event ...
+type Event struct {
+	Key Key
+	// zero-based screen position of a mouse click (for MouseClickKey only)
+	Column int
+	Row    int
+}
+
+// ...
+const (
+	EnableMouse  = "\x1b[?1000h\x1b[?1006h"
+	DisableMouse = "\x1b[?1006l\x1b[?1000l"
+)
+
+// ...
+const (
+	escapeByte    = 0x1b
+	interruptByte = 0x03 // Ctrl+C
+	endOfFileByte = 0x04 // Ctrl+D
+)
+
+// ReadEvent ...
+//
+// It reads an event from the terminal in the raw mode. It recognizes arrows
+// (in both the normal and the application cursor modes), Enter, keys
+// for quitting (q, Ctrl+C and Ctrl+D) and clicks of the left mouse button
+// (in the SGR extended mouse mode, see EnableMouse).
+//
+func ReadEvent(reader io.ByteReader) (Event, error) {
+	symbol, err := reader.ReadByte()
+	if err != nil {
+		return Event{}, err // don't wrap
+	}
+
+	switch symbol {
+	case '\r', '\n':
+		return Event{Key: EnterKey}, nil
+	case 'q', interruptByte, endOfFileByte:
+		return Event{Key: QuitKey}, nil
+	case escapeByte:
+		return readEscapeSequence(reader)
+	default:
+		return Event{Key: UnknownKey}, nil
+	}
+}
+
+func readEscapeSequence(reader io.ByteReader) (Event, error) {
+	introducer, err := reader.ReadByte()
+	if err != nil {
+		return Event{}, err // don't wrap
+	}
+	if introducer != '[' && introducer != 'O' {
+		return Event{Key: UnknownKey}, nil
+	}
+
+	final, err := reader.ReadByte()
+	if err != nil {
+		return Event{}, err // don't wrap
+	}
+
+	var key Key
+	switch final {
+	case 'A':
+		key = UpKey
+	case 'B':
+		key = DownKey
+	case 'C':
+		key = RightKey
+	case 'D':
+		key = LeftKey
+	case '<':
+		return readMouseEvent(reader)
+	default:
+		key = UnknownKey
+	}
+
+	return Event{Key: key}, nil
+}
+
+// it reads the rest of a sequence like "\x1b[<0;12;5M" after the "<" symbol
+func readMouseEvent(reader io.ByteReader) (Event, error) {
+	var parameters strings.Builder
+	var final byte
+	for {
+		symbol, err := reader.ReadByte()
+		if err != nil {
+			return Event{}, err // don't wrap
+		}
+		if symbol == 'M' || symbol == 'm' {
+			final = symbol
+			break
+		}
+
+		parameters.WriteByte(symbol)
+	}
+
+	parts := strings.Split(parameters.String(), ";")
+	if len(parts) != 3 || final != 'M' || parts[0] != "0" {
+		// it isn't a press of the left button
+		return Event{Key: UnknownKey}, nil
+	}
+
+	column, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Event{Key: UnknownKey}, nil
+	}
+
+	row, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return Event{Key: UnknownKey}, nil
+	}
+
+	event := Event{
+		Key:    MouseClickKey,
+		Column: column - 1,
+		Row:    row - 1,
+	}
+	return event, nil
+}
@@ -0,0 +1,116 @@
+package terminal
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestReadEvent(test *testing.T) {
+	type args struct {
+		input string
+	}
+	type data struct {
+		args    args
+		want    Event
+		wantErr error
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"\x1b[A"},
+			want:    Event{Key: UpKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[B"},
+			want:    Event{Key: DownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1bOC"},
+			want:    Event{Key: RightKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[D"},
+			want:    Event{Key: LeftKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[Z"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1bx"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\r"},
+			want:    Event{Key: EnterKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"q"},
+			want:    Event{Key: QuitKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x03"},
+			want:    Event{Key: QuitKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"x"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[<0;12;5M"},
+			want:    Event{Key: MouseClickKey, Column: 11, Row: 4},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[<0;12;5m"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[<2;12;5M"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{"\x1b[<0;x;5M"},
+			want:    Event{Key: UnknownKey},
+			wantErr: nil,
+		},
+		{
+			args:    args{""},
+			want:    Event{},
+			wantErr: io.EOF,
+		},
+		{
+			args:    args{"\x1b["},
+			want:    Event{},
+			wantErr: io.EOF,
+		},
+		{
+			args:    args{"\x1b[<0;12"},
+			want:    Event{},
+			wantErr: io.EOF,
+		},
+	} {
+		reader := bytes.NewReader([]byte(data.args.input))
+		got, gotErr := ReadEvent(reader)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package terminal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ...
+const (
+	EnterAlternateScreen = "\x1b[?1049h"
+	ExitAlternateScreen  = "\x1b[?1049l"
+	HideCursor           = "\x1b[?25l"
+	ShowCursor           = "\x1b[?25h"
+	ClearScreen          = "\x1b[H\x1b[2J"
+	// the raw mode requires the carriage return in addition to the line feed
+	RawLineBreak = "\r\n"
+)
+
+// EnableRawMode ...
+//
+// It switches the terminal attached to the standard input to the raw mode
+// via the stty utility. It returns a function for restoring the previous
+// mode.
+//
+func EnableRawMode() (restore func() error, err error) {
+	state, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the terminal mode: %s", err)
+	}
+
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, fmt.Errorf("unable to set the raw mode: %s", err)
+	}
+
+	restore = func() error {
+		if _, err := stty(strings.TrimSpace(state)); err != nil {
+			return fmt.Errorf("unable to restore the terminal mode: %s", err)
+		}
+
+		return nil
+	}
+	return restore, nil
+}
+
+func stty(arguments ...string) (string, error) {
+	command := exec.Command("stty", arguments...)
+	command.Stdin = os.Stdin
+
+	output, err := command.Output()
+	if err != nil {
+		return "", err // don't wrap
+	}
+
+	return string(output), nil
+}